@@ -1,15 +1,22 @@
 package main
 
 import (
+	"context"
 	"log/slog"
+	"os"
+	"time"
 
 	"github.com/braunkc/todo-app/api-service-demo/config"
 	client "github.com/braunkc/todo-app/api-service-demo/internal/grpc"
 	server "github.com/braunkc/todo-app/api-service-demo/internal/http"
+	"github.com/braunkc/todo-app/api-service-demo/internal/http/routes"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ratelimit"
 	"github.com/braunkc/todo-app/api-service-demo/internal/token"
+	"github.com/braunkc/todo-app/api-service-demo/pkg/certs"
 	"github.com/braunkc/todo-app/api-service-demo/pkg/log"
 	pb "github.com/braunkc/todo-app/api-service-demo/proto/database"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -30,7 +37,38 @@ func main() {
 	}
 	l.Debug("config inited", slog.Any("cfg", cfg))
 
-	conn, err := grpc.NewClient(cfg.DatabaseService.GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if cfg.IdentitySecretKey == "" {
+		l.Error("identity secret key is not set")
+		os.Exit(1)
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsCfg := cfg.DatabaseService.TLS; tlsCfg.Enabled {
+		reloader, err := certs.NewReloader(certs.Config{
+			CertFile: tlsCfg.CertFile,
+			KeyFile:  tlsCfg.KeyFile,
+			CAFile:   tlsCfg.CAFile,
+		})
+		if err != nil {
+			l.Error("failed to load certificates", slog.String("err", err.Error()))
+			os.Exit(1)
+		}
+
+		if tlsCfg.ReloadInterval > 0 {
+			go reloader.Watch(context.Background(), tlsCfg.ReloadInterval, func(err error) {
+				l.Error("failed to reload certificates", slog.String("err", err.Error()))
+			})
+		}
+
+		creds = credentials.NewTLS(reloader.ClientConfig(tlsCfg.ServerName))
+	}
+
+	signer := token.NewAssertionSigner([]byte(cfg.IdentitySecretKey), time.Minute)
+
+	conn, err := grpc.NewClient(cfg.DatabaseService.GRPCAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(client.IdentityInterceptor(signer)),
+		grpc.WithStreamInterceptor(client.IdentityStreamInterceptor(signer)))
 	if err != nil {
 	}
 	defer conn.Close()
@@ -38,7 +76,12 @@ func main() {
 
 	jwtService := token.NewJWTService([]byte(cfg.SecretKey))
 	dbService := client.New(c)
-	r := server.New(jwtService, dbService)
+	r, err := server.New(jwtService, dbService,
+		ratelimit.NewMemoryStore(), ratelimit.NewMemoryLockout(routes.LoginLockout), cfg.HTTPServer.TrustedProxies)
+	if err != nil {
+		l.Error("failed to create http server", slog.String("err", err.Error()))
+		os.Exit(1)
+	}
 
 	r.Run(cfg.HTTPServer.Port)
 }
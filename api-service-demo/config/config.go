@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"go.yaml.in/yaml/v3"
@@ -11,11 +13,22 @@ import (
 type Config struct {
 	HTTPServer struct {
 		Port string `yaml:"port"`
+		// proxies whose X-Forwarded-For is trusted for the client address, none if empty
+		TrustedProxies []string `yaml:"trusted-proxies"`
 	} `yaml:"http-server"`
 	DatabaseService struct {
 		GRPCAddr string
-	}
-	SecretKey string
+		TLS      struct {
+			Enabled        bool          `yaml:"enabled"`
+			CAFile         string        `yaml:"ca-file"`
+			CertFile       string        `yaml:"cert-file"`
+			KeyFile        string        `yaml:"key-file"`
+			ServerName     string        `yaml:"server-name"`
+			ReloadInterval time.Duration `yaml:"reload-interval"`
+		} `yaml:"tls"`
+	} `yaml:"database-service"`
+	SecretKey         string
+	IdentitySecretKey string
 }
 
 func New() (*Config, error) {
@@ -35,6 +48,25 @@ func New() (*Config, error) {
 
 	cfg.DatabaseService.GRPCAddr = os.Getenv("GRPC_ADDR")
 	cfg.SecretKey = os.Getenv("SECRET_KEY")
+	cfg.IdentitySecretKey = os.Getenv("IDENTITY_SECRET_KEY")
 
 	return &cfg, nil
 }
+
+// LogValue keeps the keys out of logs.
+func (c Config) LogValue() slog.Value {
+	type plain Config
+	p := plain(c)
+	p.SecretKey = redact(p.SecretKey)
+	p.IdentitySecretKey = redact(p.IdentitySecretKey)
+
+	return slog.AnyValue(p)
+}
+
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return "[redacted]"
+}
@@ -0,0 +1,182 @@
+package csvtasks
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldStatus      = "status"
+	FieldPriority    = "priority"
+	FieldDueDate     = "due_date"
+)
+
+var ErrMissingTitleColumn = errors.New("title column not found in header")
+
+var header = []string{"id", FieldTitle, FieldDescription, FieldStatus, FieldPriority, FieldDueDate, "created_at"}
+
+// Mapping maps task fields to CSV column names.
+type Mapping map[string]string
+
+func DefaultMapping() Mapping {
+	return Mapping{
+		FieldTitle:       FieldTitle,
+		FieldDescription: FieldDescription,
+		FieldStatus:      FieldStatus,
+		FieldPriority:    FieldPriority,
+		FieldDueDate:     FieldDueDate,
+	}
+}
+
+// ReadRows reads a CSV file with a header row and picks the task fields
+// from the columns named in mapping. Fields missing from mapping use the default column name.
+func ReadRows(r io.Reader, mapping Mapping) ([]dto.ImportTaskRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	head, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(head))
+	for i, name := range head {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	index := make(map[string]int)
+	for field, column := range DefaultMapping() {
+		if custom, ok := mapping[field]; ok {
+			column = custom
+		}
+
+		if i, ok := columns[strings.ToLower(strings.TrimSpace(column))]; ok {
+			index[field] = i
+		}
+	}
+
+	if _, ok := index[FieldTitle]; !ok {
+		return nil, ErrMissingTitleColumn
+	}
+
+	var rows []dto.ImportTaskRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(field string) string {
+			i, ok := index[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+
+		rows = append(rows, dto.ImportTaskRow{
+			Line:        int64(line),
+			Title:       value(FieldTitle),
+			Description: value(FieldDescription),
+			Status:      value(FieldStatus),
+			Priority:    value(FieldPriority),
+			DueDate:     value(FieldDueDate),
+		})
+	}
+
+	return rows, nil
+}
+
+type Writer struct {
+	w *csv.Writer
+}
+
+// NewWriter writes tasks in the same format ReadRows reads with the default mapping.
+func NewWriter(w io.Writer) (*Writer, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &Writer{w: writer}, nil
+}
+
+func (w *Writer) Write(tasks []dto.Task) error {
+	for _, task := range tasks {
+		if err := w.w.Write([]string{
+			task.ID,
+			task.Title,
+			task.Description,
+			statusName(task.Status),
+			priorityName(task.Priority),
+			formatDueDate(task),
+			formatTime(task.CreatedAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Writer) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func statusName(status dto.TaskStatus) string {
+	switch status {
+	case dto.TaskStatusTodo:
+		return "todo"
+	case dto.TaskStatusInProgress:
+		return "in_progress"
+	case dto.TaskStatusDone:
+		return "done"
+	default:
+		return strconv.Itoa(int(status))
+	}
+}
+
+func priorityName(priority dto.TaskPriority) string {
+	switch priority {
+	case dto.TaskPriorityLow:
+		return "low"
+	case dto.TaskPriorityMedium:
+		return "medium"
+	case dto.TaskPriorityHigh:
+		return "high"
+	default:
+		return strconv.Itoa(int(priority))
+	}
+}
+
+// formatDueDate writes only the date of all-day tasks, it's imported back as all-day.
+func formatDueDate(task dto.Task) string {
+	if task.AllDay && task.DueDate != 0 {
+		return time.Unix(task.DueDate, 0).UTC().Format(time.DateOnly)
+	}
+
+	return formatTime(task.DueDate)
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,36 @@
+package csvtasks
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+var timeReportHeader = []string{"day", "task_id", "task_title", "project", "seconds", "hours"}
+
+// WriteTimeReport writes a row per day, task and project. Hours are rounded
+// to two decimals for invoices, seconds keep the exact time.
+func WriteTimeReport(w io.Writer, report *dto.GetTimeReportResponse) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(timeReportHeader); err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		if err := writer.Write([]string{
+			row.Day,
+			row.TaskID,
+			row.TaskTitle,
+			row.Project,
+			strconv.FormatInt(row.Seconds, 10),
+			strconv.FormatFloat(float64(row.Seconds)/3600, 'f', 2, 64),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
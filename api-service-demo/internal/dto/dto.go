@@ -1,9 +1,8 @@
 package dto
 
 type User struct {
-	ID           string
-	Username     string
-	PasswordHash string
+	ID       string
+	Username string
 }
 
 type CreateUserRequest struct {
@@ -29,6 +28,12 @@ type DeleteUserByIDRequest struct {
 
 type DeleteUserByIDResponse struct{}
 
+// UserSettings holds the user's IANA timezone, empty means UTC.
+// All-day tasks are due and overdue by the end of the day in it.
+type UserSettings struct {
+	Timezone string `json:"timezone"`
+}
+
 type TaskStatus uint8
 
 const (
@@ -37,6 +42,15 @@ const (
 	TaskStatusDone
 )
 
+// StatusCategory is what a workflow status means: not started, being worked on or finished.
+type StatusCategory uint8
+
+const (
+	StatusCategoryTodo StatusCategory = iota
+	StatusCategoryActive
+	StatusCategoryDone
+)
+
 type TaskPriority uint8
 
 const (
@@ -46,21 +60,59 @@ const (
 )
 
 type Task struct {
-	ID          string `json:"id"`
-	UserID      string
-	Title       string       `json:"title"`
-	Description string       `json:"description"`
-	Status      TaskStatus   `json:"status"`
-	Priority    TaskPriority `json:"priority"`
-	DueDate     int64        `json:"due_date"`
-	CreatedAt   int64        `json:"created_at"`
+	ID              string `json:"id"`
+	UserID          string
+	AssigneeID      string         `json:"assignee_id,omitempty"`
+	ParentID        string         `json:"parent_id,omitempty"` // the task this one is a subtask of
+	Rank            string         `json:"rank"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	Status          TaskStatus     `json:"status"`
+	StatusCategory  StatusCategory `json:"status_category"`
+	Priority        TaskPriority   `json:"priority"`
+	DueDate         int64          `json:"due_date"`
+	Project         string         `json:"project"`
+	AllDay          bool           `json:"all_day"`
+	Overdue         bool           `json:"overdue"`
+	TrackedSeconds  int64          `json:"tracked_seconds"`
+	EstimateMinutes uint32         `json:"estimate_minutes"` // 0 if not estimated
+	StoryPoints     uint32         `json:"story_points"`     // 0 if not sized
+	CreatedAt       int64          `json:"created_at"`
+	UpdatedAt       int64          `json:"updated_at"`
+	StartedAt       int64          `json:"started_at"`
+	CompletedAt     int64          `json:"completed_at"`
+	Tags            []string       `json:"tags"`
 }
 
 type CreateTaskRequest struct {
-	Title       string       `json:"title"`
-	Description string       `json:"description"`
-	Priority    TaskPriority `json:"priority"`
-	DueDate     int64        `json:"due_date"`
+	Title           string       `json:"title"`
+	Description     string       `json:"description"`
+	Priority        TaskPriority `json:"priority"`
+	DueDate         int64        `json:"due_date"`
+	Project         string       `json:"project"`
+	AllDay          bool         `json:"all_day"` // only the UTC date of due_date is kept
+	EstimateMinutes uint32       `json:"estimate_minutes"`
+	StoryPoints     uint32       `json:"story_points"`
+	Tags            []string     `json:"tags"`
+}
+
+// QuickAddRequest is a task typed in one line, like "Pay rent tomorrow 9am !high #home".
+type QuickAddRequest struct {
+	Text    string `json:"text" binding:"required"`
+	Preview bool   `json:"preview"` // only parse the text, don't create the task
+}
+
+// ParsedTask is what quick add made of the text. Tasks don't repeat yet, so the
+// recurrence only sets the first due date and its words are reported in Unparsed.
+type ParsedTask struct {
+	Task       CreateTaskRequest `json:"task"`
+	Recurrence string            `json:"recurrence,omitempty"` // like "every monday" or "every 2 weeks"
+	Unparsed   []string          `json:"unparsed,omitempty"`   // phrases left out of the title that the task doesn't keep
+}
+
+type QuickAddResponse struct {
+	Parsed ParsedTask `json:"parsed"`
+	Task   *Task      `json:"task,omitempty"` // the created task, nil in preview
 }
 
 type CreateTaskResponse struct {
@@ -78,6 +130,25 @@ type GetTaskResponse struct {
 type Filters struct {
 	TaskStatuses   []TaskStatus   `json:"task_statuses"`
 	TaskPriorities []TaskPriority `json:"task_priorities"`
+	// date (YYYY-MM-DD) or RFC3339 time, bounds are inclusive
+	DueBefore     string `json:"due_before,omitempty"`
+	DueAfter      string `json:"due_after,omitempty"`
+	CreatedBefore string `json:"created_before,omitempty"`
+	CreatedAfter  string `json:"created_after,omitempty"`
+	OverdueOnly   bool   `json:"overdue_only,omitempty"`
+	HasDueDate    *bool  `json:"has_due_date,omitempty"`
+	HasEstimate   *bool  `json:"has_estimate,omitempty"`
+	// estimated tasks expected to take at most that many minutes
+	MaxEstimateMinutes uint32   `json:"max_estimate_minutes,omitempty"`
+	StoryPoints        []uint32 `json:"story_points,omitempty"`
+	// tasks other users shared with the user instead of the user's own
+	SharedWithMe bool `json:"shared_with_me,omitempty"`
+	// tasks assigned to the user instead of the user's own
+	AssignedToMe bool `json:"assigned_to_me,omitempty"`
+	// tasks assigned to the user with that id
+	AssigneeID string `json:"assignee,omitempty"`
+	// IANA timezone used for date bounds, UTC if empty
+	Timezone string `json:"timezone,omitempty"`
 }
 
 type SortField uint8
@@ -86,6 +157,13 @@ const (
 	Priority SortField = iota
 	DueDate
 	CreatedAt
+	Title
+	Status
+	UpdatedAt
+	CompletedAt
+	Estimate
+	StoryPoints
+	Manual // the order the owner arranged the tasks in
 )
 
 type SortDirection uint8
@@ -95,17 +173,27 @@ const (
 	Desc
 )
 
+// NullsOrder places tasks without a value (no due date, not completed) last or first.
+type NullsOrder uint8
+
+const (
+	NullsLast NullsOrder = iota
+	NullsFirst
+)
+
 type OrderBy struct {
 	Field     SortField     `json:"field"`
 	Direction SortDirection `json:"direction"`
+	Nulls     NullsOrder    `json:"nulls"`
 }
 
 type GetTasksRequest struct {
-	PageSize   int64   `json:"page_size"`
-	PageNumber int64   `json:"page_number"`
-	Filters    Filters `json:"filters"`
-	OrderBy    OrderBy `json:"order_by"`
-	Title      string  `json:"title"`
+	PageSize   int64     `json:"page_size"`
+	PageNumber int64     `json:"page_number"`
+	Filters    Filters   `json:"filters"`
+	OrderBy    OrderBy   `json:"order_by"`
+	SortKeys   []OrderBy `json:"sort_keys"` // by precedence, replaces order_by when set
+	Title      string    `json:"title"`
 }
 
 type GetTasksResponse struct {
@@ -120,7 +208,14 @@ type UpdateTaskRequest struct {
 	Description *string       `json:"description"`
 	Status      *TaskStatus   `json:"status"`
 	Priority    *TaskPriority `json:"priority"`
-	DueDate     *int64        `json:"due_date"`
+	DueDate     *int64        `json:"due_date"` // may be in the past, 0 clears it
+	Project     *string       `json:"project"`  // "" takes the task out of its project
+	AllDay      *bool         `json:"all_day"`
+	// 0 removes the estimate or story points
+	EstimateMinutes *uint32 `json:"estimate_minutes"`
+	StoryPoints     *uint32 `json:"story_points"`
+	// an empty list removes the tags
+	Tags *[]string `json:"tags"`
 }
 
 type UpdateTaskResponse struct {
@@ -132,3 +227,363 @@ type DeleteTasksByIDRequest struct {
 }
 
 type DeleteTasksByIDResponse struct{}
+
+type TaskPatch struct {
+	Status   *TaskStatus   `json:"status"`
+	Priority *TaskPriority `json:"priority"`
+	DueDate  *int64        `json:"due_date"`
+	Project  *string       `json:"project"` // "" takes the tasks out of their project
+}
+
+type BulkUpdateTasksRequest struct {
+	IDs     []string  `json:"ids"`
+	Filters *Filters  `json:"filters"`
+	Patch   TaskPatch `json:"patch"`
+}
+
+type BulkUpdateTaskResult struct {
+	ID      string `json:"id"`
+	Updated bool   `json:"updated"`
+	Error   string `json:"error,omitempty"`
+}
+
+type BulkUpdateTasksResponse struct {
+	Results      []BulkUpdateTaskResult `json:"results"`
+	UpdatedCount int64                  `json:"updated_count"`
+}
+
+type ImportTaskRow struct {
+	Line        int64    `json:"line"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	Priority    string   `json:"priority"`
+	DueDate     string   `json:"due_date"`
+	Project     string   `json:"project"`
+	Tags        []string `json:"tags"`
+}
+
+type ImportTasksRequest struct {
+	DryRun bool
+	Rows   []ImportTaskRow
+}
+
+type ImportRowResult struct {
+	Line      int64    `json:"line"`
+	TaskID    string   `json:"task_id,omitempty"`
+	Duplicate bool     `json:"duplicate"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+type ImportTasksResponse struct {
+	DryRun         bool              `json:"dry_run"`
+	Results        []ImportRowResult `json:"results"`
+	CreatedCount   int64             `json:"created_count"`
+	DuplicateCount int64             `json:"duplicate_count"`
+	FailedCount    int64             `json:"failed_count"`
+}
+
+type SkippedItem struct {
+	Ref    string `json:"ref"` // ID or line of the item in the source file
+	Title  string `json:"title"`
+	Reason string `json:"reason"`
+}
+
+type ImportReport struct {
+	Source string `json:"source"`
+	ImportTasksResponse
+	Skipped      []SkippedItem `json:"skipped"`
+	SkippedCount int64         `json:"skipped_count"`
+	Notes        []SkippedItem `json:"notes"` // items imported without some of their fields
+}
+
+type StatsBucket uint8
+
+const (
+	BucketDay StatsBucket = iota
+	BucketWeek
+)
+
+type GetTaskStatsRequest struct {
+	From     string      `form:"from"` // date (YYYY-MM-DD) or RFC3339 time
+	To       string      `form:"to"`
+	Bucket   StatsBucket `form:"bucket"`
+	Timezone string      `form:"timezone"`
+}
+
+type StatusCount struct {
+	Status TaskStatus `json:"status"`
+	Count  int64      `json:"count"`
+}
+
+type PriorityCount struct {
+	Priority TaskPriority `json:"priority"`
+	Count    int64        `json:"count"`
+}
+
+type CompletedBucket struct {
+	Start int64 `json:"start"`
+	Count int64 `json:"count"`
+}
+
+type GetTaskStatsResponse struct {
+	OpenByStatus         []StatusCount     `json:"open_by_status"`
+	OpenByPriority       []PriorityCount   `json:"open_by_priority"`
+	OverdueCount         int64             `json:"overdue_count"`
+	Completed            []CompletedBucket `json:"completed"`
+	CompletedCount       int64             `json:"completed_count"`
+	AvgCompletionSeconds int64             `json:"avg_completion_seconds"` // lead time
+	AvgCycleTimeSeconds  int64             `json:"avg_cycle_time_seconds"`
+	// remaining effort of the open tasks
+	OpenEstimateMinutes  int64 `json:"open_estimate_minutes"`
+	OpenStoryPoints      int64 `json:"open_story_points"`
+	CompletedStoryPoints int64 `json:"completed_story_points"`
+}
+
+type CreateCalendarFeedResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type ResolveCalendarFeedRequest struct {
+	Token string
+}
+
+type ResolveCalendarFeedResponse struct {
+	UserID string
+}
+
+type WorkflowStatus struct {
+	ID       TaskStatus     `json:"id"`
+	Name     string         `json:"name"`
+	Category StatusCategory `json:"category"`
+}
+
+type WorkflowTransition struct {
+	From TaskStatus `json:"from"`
+	To   TaskStatus `json:"to"`
+}
+
+type Workflow struct {
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+	IsDefault   bool                 `json:"is_default"`
+}
+
+// UpdateWorkflowRequest replaces the user's workflow, no statuses resets it to the default one.
+// The first status is the one new tasks start in, no transitions allows any move.
+type UpdateWorkflowRequest struct {
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+type TimeEntry struct {
+	ID        string `json:"id"`
+	TaskID    string `json:"task_id"`
+	Project   string `json:"project"`
+	StartedAt int64  `json:"started_at"`
+	StoppedAt int64  `json:"stopped_at"` // 0 while the timer runs
+	Seconds   int64  `json:"seconds"`
+}
+
+type StartTimerRequest struct {
+	TaskID  string `json:"task_id"`
+	Project string `json:"project"`
+}
+
+type StartTimerResponse struct {
+	Entry   TimeEntry  `json:"entry"`
+	Stopped *TimeEntry `json:"stopped,omitempty"` // the timer that was running before
+}
+
+type AddTimeEntryRequest struct {
+	TaskID    string `json:"task_id"`
+	Project   string `json:"project"`
+	StartedAt int64  `json:"started_at"`
+	StoppedAt int64  `json:"stopped_at"`
+}
+
+type ListTimeEntriesRequest struct {
+	TaskID   string `form:"task_id"`
+	From     string `form:"from"` // date (YYYY-MM-DD) or RFC3339 time
+	To       string `form:"to"`
+	Timezone string `form:"timezone"`
+}
+
+type GetTimeReportRequest struct {
+	From     string `form:"from"`
+	To       string `form:"to"`
+	Timezone string `form:"timezone"`
+}
+
+type TimeReportRow struct {
+	Day       string `json:"day"`
+	TaskID    string `json:"task_id"`
+	TaskTitle string `json:"task_title"`
+	Project   string `json:"project"`
+	Seconds   int64  `json:"seconds"`
+}
+
+type GetTimeReportResponse struct {
+	Rows         []TimeReportRow `json:"rows"`
+	TotalSeconds int64           `json:"total_seconds"`
+}
+
+// TaskRole is what a user can do with a task: viewers read it, editors also change it,
+// owners also delete and share it.
+type TaskRole uint8
+
+const (
+	Viewer TaskRole = iota
+	Editor
+	Owner
+)
+
+type Collaborator struct {
+	UserID   string   `json:"user_id"`
+	Username string   `json:"username"`
+	Role     TaskRole `json:"role"`
+}
+
+type ShareTaskRequest struct {
+	TaskID   string   `json:"-"`
+	Username string   `json:"username" binding:"required"`
+	Role     TaskRole `json:"role"`
+}
+
+type UnshareTaskRequest struct {
+	TaskID   string
+	Username string
+}
+
+// UndoResponse is the result of undoing or redoing a task operation.
+type UndoResponse struct {
+	Operation      string   `json:"operation"`        // create, update, delete, bulk_update, import, assign or move
+	Tasks          []Task   `json:"tasks"`            // the tasks as they are now
+	DeletedTaskIDs []string `json:"deleted_task_ids"` // tasks that were removed
+}
+
+// MoveTaskRequest places the task between BeforeID, the task that ends up right before it,
+// and AfterID, the one right after it. Either is empty for the start or the end of the list.
+type MoveTaskRequest struct {
+	ID       string      `json:"-"`
+	BeforeID string      `json:"before_id"`
+	AfterID  string      `json:"after_id"`
+	Status   *TaskStatus `json:"status"` // moves the task to another column too if set
+}
+
+// AssignTaskRequest assigns the task to a member of its workspace, an empty username unassigns it.
+type AssignTaskRequest struct {
+	TaskID   string `json:"-"`
+	Username string `json:"username"`
+}
+
+// WorkspaceRole is what a member can do in a workspace: members work on their tasks,
+// admins also invite users, the owner created it and can't leave it.
+type WorkspaceRole uint8
+
+const (
+	Member WorkspaceRole = iota
+	Admin
+	WorkspaceOwner
+)
+
+type Workspace struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Role     WorkspaceRole `json:"role"`
+	Personal bool          `json:"personal"`
+	// the workspace the token works in
+	Active bool `json:"active"`
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type InviteToWorkspaceRequest struct {
+	WorkspaceID string        `json:"-"`
+	Username    string        `json:"username" binding:"required"`
+	Role        WorkspaceRole `json:"role"`
+}
+
+type WorkspaceInvitation struct {
+	ID            string        `json:"id"`
+	WorkspaceID   string        `json:"workspace_id"`
+	WorkspaceName string        `json:"workspace_name"`
+	InvitedBy     string        `json:"invited_by"`
+	Role          WorkspaceRole `json:"role"`
+	CreatedAt     int64         `json:"created_at"`
+}
+
+// TaskTemplateItem is a task of a template. Title and description may hold {{name}}
+// placeholders, {{date}} is the day the template is instantiated on. DueOffset is like "+3d":
+// hours (h) make a timed due date, days (d) and weeks (w) an all-day one.
+type TaskTemplateItem struct {
+	Title       string       `json:"title" binding:"required"`
+	Description string       `json:"description"`
+	Priority    TaskPriority `json:"priority"`
+	DueOffset   string       `json:"due_offset"` // empty for no due date
+}
+
+type TaskTemplate struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Task      TaskTemplateItem   `json:"task"`
+	Tags      []string           `json:"tags"` // put on the task and every subtask
+	Subtasks  []TaskTemplateItem `json:"subtasks"`
+	CreatedAt int64              `json:"created_at"`
+	UpdatedAt int64              `json:"updated_at"`
+}
+
+// TaskTemplateRequest creates a template or replaces everything an existing one captures.
+type TaskTemplateRequest struct {
+	ID       string             `json:"-"`
+	Name     string             `json:"name" binding:"required"`
+	Task     TaskTemplateItem   `json:"task"`
+	Tags     []string           `json:"tags"`
+	Subtasks []TaskTemplateItem `json:"subtasks" binding:"dive"`
+}
+
+// InstantiateTemplateRequest fills in every placeholder of the template but {{date}} from Variables.
+type InstantiateTemplateRequest struct {
+	TemplateID string            `json:"-"`
+	Variables  map[string]string `json:"variables"`
+}
+
+// Session is a login of the user on a device, Device is its user agent.
+type Session struct {
+	ID              string `json:"id"`
+	UserID          string `json:"-"`
+	WorkspaceID     string `json:"-"`
+	Device          string `json:"device"`
+	IP              string `json:"ip"`
+	CreatedAt       int64  `json:"created_at"`
+	LastRefreshedAt int64  `json:"last_refreshed_at"` // updated when the session is refreshed
+	ExpiresAt       int64  `json:"expires_at"`
+	// the session the request is made in
+	Current bool `json:"current"`
+}
+
+type CreateSessionRequest struct {
+	Device string
+	IP     string
+}
+
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"refresh_token"` // taken from the Refresh cookie if it's empty
+	Device       string `json:"-"`
+	IP           string `json:"-"`
+}
+
+// SessionResponse is a session with its refresh token, which is only ever returned once.
+type SessionResponse struct {
+	Session      Session
+	RefreshToken string
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // seconds the access token works
+}
@@ -2,11 +2,9 @@ package client
 
 import (
 	"context"
-	"errors"
 
 	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
 	pb "github.com/braunkc/todo-app/api-service-demo/proto/database"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type databaseService struct {
@@ -17,12 +15,60 @@ type DatabaseService interface {
 	CreateUser(ctx context.Context, req *dto.CreateUserRequest) (*dto.CreateUserResponse, error)
 	Authenticate(ctx context.Context, username, password string) (*dto.User, error)
 	DeleteUserByID(ctx context.Context, req *dto.DeleteUserByIDRequest) (*dto.DeleteUserByIDResponse, error)
+	GetUserSettings(ctx context.Context) (*dto.UserSettings, error)
+	UpdateUserSettings(ctx context.Context, req *dto.UserSettings) (*dto.UserSettings, error)
 
 	CreateTask(ctx context.Context, req *dto.CreateTaskRequest) (*dto.CreateTaskResponse, error)
 	GetTask(ctx context.Context, req *dto.GetTaskRequest) (*dto.GetTaskResponse, error)
 	GetTasks(ctx context.Context, req *dto.GetTasksRequest) (*dto.GetTasksResponse, error)
 	UpdateTask(ctx context.Context, req *dto.UpdateTaskRequest) (*dto.UpdateTaskResponse, error)
 	DeleteTasksByID(ctx context.Context, req *dto.DeleteTasksByIDRequest) (*dto.DeleteTasksByIDResponse, error)
+	BulkUpdateTasks(ctx context.Context, req *dto.BulkUpdateTasksRequest) (*dto.BulkUpdateTasksResponse, error)
+	ImportTasks(ctx context.Context, req *dto.ImportTasksRequest) (*dto.ImportTasksResponse, error)
+	GetTaskStats(ctx context.Context, req *dto.GetTaskStatsRequest) (*dto.GetTaskStatsResponse, error)
+
+	GetWorkflow(ctx context.Context) (*dto.Workflow, error)
+	UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*dto.Workflow, error)
+
+	StartTimer(ctx context.Context, req *dto.StartTimerRequest) (*dto.StartTimerResponse, error)
+	StopTimer(ctx context.Context) (*dto.TimeEntry, error)
+	AddTimeEntry(ctx context.Context, req *dto.AddTimeEntryRequest) (*dto.TimeEntry, error)
+	ListTimeEntries(ctx context.Context, req *dto.ListTimeEntriesRequest) ([]dto.TimeEntry, error)
+	GetTimeReport(ctx context.Context, req *dto.GetTimeReportRequest) (*dto.GetTimeReportResponse, error)
+
+	ShareTask(ctx context.Context, req *dto.ShareTaskRequest) (*dto.Collaborator, error)
+	UnshareTask(ctx context.Context, req *dto.UnshareTaskRequest) error
+	ListCollaborators(ctx context.Context, taskID string) ([]dto.Collaborator, error)
+	AssignTask(ctx context.Context, req *dto.AssignTaskRequest) (*dto.Task, error)
+	MoveTask(ctx context.Context, req *dto.MoveTaskRequest) (*dto.Task, error)
+	Undo(ctx context.Context) (*dto.UndoResponse, error)
+	Redo(ctx context.Context) (*dto.UndoResponse, error)
+
+	CreateTaskTemplate(ctx context.Context, req *dto.TaskTemplateRequest) (*dto.TaskTemplate, error)
+	ListTaskTemplates(ctx context.Context) ([]dto.TaskTemplate, error)
+	GetTaskTemplate(ctx context.Context, ID string) (*dto.TaskTemplate, error)
+	UpdateTaskTemplate(ctx context.Context, req *dto.TaskTemplateRequest) (*dto.TaskTemplate, error)
+	DeleteTaskTemplate(ctx context.Context, ID string) error
+	InstantiateTemplate(ctx context.Context, req *dto.InstantiateTemplateRequest) ([]dto.Task, error)
+
+	CreateWorkspace(ctx context.Context, req *dto.CreateWorkspaceRequest) (*dto.Workspace, error)
+	ListWorkspaces(ctx context.Context) ([]dto.Workspace, error)
+	InviteToWorkspace(ctx context.Context, req *dto.InviteToWorkspaceRequest) (*dto.WorkspaceInvitation, error)
+	ListWorkspaceInvitations(ctx context.Context) ([]dto.WorkspaceInvitation, error)
+	RespondToWorkspaceInvitation(ctx context.Context, invitationID string, accept bool) (*dto.Workspace, error)
+	LeaveWorkspace(ctx context.Context, workspaceID string) error
+
+	CreateCalendarFeed(ctx context.Context) (*dto.CreateCalendarFeedResponse, error)
+	RevokeCalendarFeed(ctx context.Context) error
+	ResolveCalendarFeed(ctx context.Context, req *dto.ResolveCalendarFeedRequest) (*dto.ResolveCalendarFeedResponse, error)
+
+	CreateSession(ctx context.Context, req *dto.CreateSessionRequest) (*dto.SessionResponse, error)
+	RefreshSession(ctx context.Context, req *dto.RefreshSessionRequest) (*dto.SessionResponse, error)
+	GetSession(ctx context.Context, sessionID string) (*dto.Session, error)
+	ListSessions(ctx context.Context) ([]dto.Session, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeAllSessions(ctx context.Context) error
+	SwitchSessionWorkspace(ctx context.Context, sessionID, workspaceID string) (*dto.Session, error)
 }
 
 func New(dbClient pb.DataBaseServiceClient) DatabaseService {
@@ -49,17 +95,14 @@ func (db *databaseService) CreateUser(ctx context.Context, req *dto.CreateUserRe
 }
 
 func (db *databaseService) Authenticate(ctx context.Context, username, password string) (*dto.User, error) {
-	resp, err := db.client.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{
+	resp, err := db.client.Authenticate(ctx, &pb.AuthenticateRequest{
 		Username: username,
+		Password: password,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(resp.User.PasswordHash), []byte(password)); err != nil {
-		return nil, errors.New("invalid username or password")
-	}
-
 	return &dto.User{
 		ID:       resp.User.Id,
 		Username: resp.User.Username,
@@ -77,12 +120,41 @@ func (db *databaseService) DeleteUserByID(ctx context.Context, req *dto.DeleteUs
 	return &dto.DeleteUserByIDResponse{}, nil
 }
 
+func (db *databaseService) GetUserSettings(ctx context.Context) (*dto.UserSettings, error) {
+	resp, err := db.client.GetUserSettings(ctx, &pb.GetUserSettingsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserSettings{
+		Timezone: resp.Timezone,
+	}, nil
+}
+
+func (db *databaseService) UpdateUserSettings(ctx context.Context, req *dto.UserSettings) (*dto.UserSettings, error) {
+	resp, err := db.client.UpdateUserSettings(ctx, &pb.UpdateUserSettingsRequest{
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserSettings{
+		Timezone: resp.Timezone,
+	}, nil
+}
+
 func (db *databaseService) CreateTask(ctx context.Context, req *dto.CreateTaskRequest) (*dto.CreateTaskResponse, error) {
 	resp, err := db.client.CreateTask(ctx, &pb.CreateTaskRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    pb.TaskPriority(req.Priority),
-		DueDate:     req.DueDate,
+		Title:           req.Title,
+		Description:     req.Description,
+		Priority:        pb.TaskPriority(req.Priority),
+		DueDate:         req.DueDate,
+		Project:         req.Project,
+		AllDay:          req.AllDay,
+		EstimateMinutes: req.EstimateMinutes,
+		StoryPoints:     req.StoryPoints,
+		Tags:            req.Tags,
 	})
 	if err != nil {
 		return nil, err
@@ -107,28 +179,18 @@ func (db *databaseService) GetTask(ctx context.Context, req *dto.GetTaskRequest)
 }
 
 func (db *databaseService) GetTasks(ctx context.Context, req *dto.GetTasksRequest) (*dto.GetTasksResponse, error) {
-	taskStatuses := make([]pb.TaskStatus, 0, len(req.Filters.TaskStatuses))
-	for _, status := range req.Filters.TaskStatuses {
-		taskStatuses = append(taskStatuses, pb.TaskStatus(status))
-	}
-
-	taskPriorities := make([]pb.TaskPriority, 0, len(req.Filters.TaskPriorities))
-	for _, priority := range req.Filters.TaskPriorities {
-		taskPriorities = append(taskPriorities, pb.TaskPriority(priority))
+	sortKeys := make([]*pb.OrderBy, 0, len(req.SortKeys))
+	for _, key := range req.SortKeys {
+		sortKeys = append(sortKeys, mapOrderByToPB(key))
 	}
 
 	resp, err := db.client.GetTasks(ctx, &pb.GetTasksRequest{
 		PageSize:   req.PageSize,
 		PageNumber: req.PageNumber,
-		Filters: &pb.Filters{
-			TaskStatuses:   taskStatuses,
-			TaskPriorities: taskPriorities,
-		},
-		OrderBy: &pb.OrderBy{
-			Field:     pb.SortField(req.OrderBy.Field),
-			Direction: pb.SortDirection(req.OrderBy.Direction),
-		},
-		Title: &req.Title,
+		Filters:    mapFiltersToPB(req.Filters),
+		OrderBy:    mapOrderByToPB(req.OrderBy),
+		SortKeys:   sortKeys,
+		Title:      &req.Title,
 	})
 	if err != nil {
 		return nil, err
@@ -161,13 +223,23 @@ func (db *databaseService) UpdateTask(ctx context.Context, req *dto.UpdateTaskRe
 		priority = ptr(pb.TaskPriority(*req.Priority))
 	}
 
+	var tags *pb.TaskTags
+	if req.Tags != nil {
+		tags = &pb.TaskTags{Tags: *req.Tags}
+	}
+
 	resp, err := db.client.UpdateTask(ctx, &pb.UpdateTaskRequest{
-		Id:          req.ID,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      status,
-		Priority:    priority,
-		DueDate:     req.DueDate,
+		Id:              req.ID,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          status,
+		Priority:        priority,
+		DueDate:         req.DueDate,
+		Project:         req.Project,
+		AllDay:          req.AllDay,
+		EstimateMinutes: req.EstimateMinutes,
+		StoryPoints:     req.StoryPoints,
+		Tags:            tags,
 	})
 	if err != nil {
 		return nil, err
@@ -189,15 +261,838 @@ func (db *databaseService) DeleteTasksByID(ctx context.Context, req *dto.DeleteT
 	return &dto.DeleteTasksByIDResponse{}, nil
 }
 
+func (db *databaseService) BulkUpdateTasks(ctx context.Context, req *dto.BulkUpdateTasksRequest) (*dto.BulkUpdateTasksResponse, error) {
+	var filters *pb.Filters
+	if req.Filters != nil {
+		filters = mapFiltersToPB(*req.Filters)
+	}
+
+	patch := pb.TaskPatch{
+		DueDate: req.Patch.DueDate,
+		Project: req.Patch.Project,
+	}
+	if req.Patch.Status != nil {
+		patch.Status = ptr(pb.TaskStatus(*req.Patch.Status))
+	}
+	if req.Patch.Priority != nil {
+		patch.Priority = ptr(pb.TaskPriority(*req.Patch.Priority))
+	}
+
+	resp, err := db.client.BulkUpdateTasks(ctx, &pb.BulkUpdateTasksRequest{
+		Ids:     req.IDs,
+		Filters: filters,
+		Patch:   &patch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.BulkUpdateTaskResult, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		results = append(results, dto.BulkUpdateTaskResult{
+			ID:      result.Id,
+			Updated: result.Updated,
+			Error:   result.Error,
+		})
+	}
+
+	return &dto.BulkUpdateTasksResponse{
+		Results:      results,
+		UpdatedCount: resp.UpdatedCount,
+	}, nil
+}
+
+const importBatchSize = 500
+
+// ImportTasks streams the rows to database-service in batches.
+func (db *databaseService) ImportTasks(ctx context.Context, req *dto.ImportTasksRequest) (*dto.ImportTasksResponse, error) {
+	stream, err := db.client.ImportTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for start := 0; start == 0 || start < len(req.Rows); start += importBatchSize {
+		end := min(start+importBatchSize, len(req.Rows))
+
+		rows := make([]*pb.ImportTaskRow, 0, end-start)
+		for _, row := range req.Rows[start:end] {
+			rows = append(rows, &pb.ImportTaskRow{
+				Line:        row.Line,
+				Title:       row.Title,
+				Description: row.Description,
+				Status:      row.Status,
+				Priority:    row.Priority,
+				DueDate:     row.DueDate,
+				Project:     row.Project,
+				Tags:        row.Tags,
+			})
+		}
+
+		if err := stream.Send(&pb.ImportTasksRequest{
+			DryRun: req.DryRun,
+			Rows:   rows,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.ImportRowResult, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		results = append(results, dto.ImportRowResult{
+			Line:      result.Line,
+			TaskID:    result.TaskId,
+			Duplicate: result.Duplicate,
+			Errors:    result.Errors,
+		})
+	}
+
+	return &dto.ImportTasksResponse{
+		DryRun:         req.DryRun,
+		Results:        results,
+		CreatedCount:   resp.CreatedCount,
+		DuplicateCount: resp.DuplicateCount,
+		FailedCount:    resp.FailedCount,
+	}, nil
+}
+
+func (db *databaseService) GetTaskStats(ctx context.Context, req *dto.GetTaskStatsRequest) (*dto.GetTaskStatsResponse, error) {
+	resp, err := db.client.GetTaskStats(ctx, &pb.GetTaskStatsRequest{
+		From:     req.From,
+		To:       req.To,
+		Bucket:   pb.StatsBucket(req.Bucket),
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &dto.GetTaskStatsResponse{
+		OpenByStatus:         make([]dto.StatusCount, 0, len(resp.OpenByStatus)),
+		OpenByPriority:       make([]dto.PriorityCount, 0, len(resp.OpenByPriority)),
+		OverdueCount:         resp.OverdueCount,
+		Completed:            make([]dto.CompletedBucket, 0, len(resp.Completed)),
+		CompletedCount:       resp.CompletedCount,
+		AvgCompletionSeconds: resp.AvgCompletionSeconds,
+		AvgCycleTimeSeconds:  resp.AvgCycleTimeSeconds,
+		OpenEstimateMinutes:  resp.OpenEstimateMinutes,
+		OpenStoryPoints:      resp.OpenStoryPoints,
+		CompletedStoryPoints: resp.CompletedStoryPoints,
+	}
+
+	for _, c := range resp.OpenByStatus {
+		stats.OpenByStatus = append(stats.OpenByStatus, dto.StatusCount{
+			Status: dto.TaskStatus(c.Status),
+			Count:  c.Count,
+		})
+	}
+
+	for _, c := range resp.OpenByPriority {
+		stats.OpenByPriority = append(stats.OpenByPriority, dto.PriorityCount{
+			Priority: dto.TaskPriority(c.Priority),
+			Count:    c.Count,
+		})
+	}
+
+	for _, b := range resp.Completed {
+		stats.Completed = append(stats.Completed, dto.CompletedBucket{
+			Start: b.Start,
+			Count: b.Count,
+		})
+	}
+
+	return stats, nil
+}
+
+func (db *databaseService) GetWorkflow(ctx context.Context) (*dto.Workflow, error) {
+	resp, err := db.client.GetWorkflow(ctx, &pb.GetWorkflowRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapWorkflowToDTO(resp.Workflow), nil
+}
+
+func (db *databaseService) UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*dto.Workflow, error) {
+	r := pb.UpdateWorkflowRequest{
+		Statuses:    make([]*pb.WorkflowStatus, 0, len(req.Statuses)),
+		Transitions: make([]*pb.WorkflowTransition, 0, len(req.Transitions)),
+	}
+
+	for _, status := range req.Statuses {
+		r.Statuses = append(r.Statuses, &pb.WorkflowStatus{
+			Id:       uint32(status.ID),
+			Name:     status.Name,
+			Category: pb.StatusCategory(status.Category),
+		})
+	}
+
+	for _, transition := range req.Transitions {
+		r.Transitions = append(r.Transitions, &pb.WorkflowTransition{
+			From: uint32(transition.From),
+			To:   uint32(transition.To),
+		})
+	}
+
+	resp, err := db.client.UpdateWorkflow(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapWorkflowToDTO(resp.Workflow), nil
+}
+
+func (db *databaseService) CreateCalendarFeed(ctx context.Context) (*dto.CreateCalendarFeedResponse, error) {
+	resp, err := db.client.CreateCalendarFeed(ctx, &pb.CreateCalendarFeedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateCalendarFeedResponse{
+		Token: resp.Token,
+	}, nil
+}
+
+func (db *databaseService) RevokeCalendarFeed(ctx context.Context) error {
+	_, err := db.client.RevokeCalendarFeed(ctx, &pb.RevokeCalendarFeedRequest{})
+	return err
+}
+
+func (db *databaseService) ResolveCalendarFeed(ctx context.Context, req *dto.ResolveCalendarFeedRequest) (*dto.ResolveCalendarFeedResponse, error) {
+	resp, err := db.client.ResolveCalendarFeed(ctx, &pb.ResolveCalendarFeedRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ResolveCalendarFeedResponse{
+		UserID: resp.UserId,
+	}, nil
+}
+
+func (db *databaseService) CreateSession(ctx context.Context, req *dto.CreateSessionRequest) (*dto.SessionResponse, error) {
+	resp, err := db.client.CreateSession(ctx, &pb.CreateSessionRequest{
+		Device: req.Device,
+		Ip:     req.IP,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SessionResponse{
+		Session:      mapSessionToDTO(resp.GetSession()),
+		RefreshToken: resp.GetRefreshToken(),
+	}, nil
+}
+
+func (db *databaseService) RefreshSession(ctx context.Context, req *dto.RefreshSessionRequest) (*dto.SessionResponse, error) {
+	resp, err := db.client.RefreshSession(ctx, &pb.RefreshSessionRequest{
+		RefreshToken: req.RefreshToken,
+		Device:       req.Device,
+		Ip:           req.IP,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SessionResponse{
+		Session:      mapSessionToDTO(resp.GetSession()),
+		RefreshToken: resp.GetRefreshToken(),
+	}, nil
+}
+
+func (db *databaseService) GetSession(ctx context.Context, sessionID string) (*dto.Session, error) {
+	resp, err := db.client.GetSession(ctx, &pb.GetSessionRequest{
+		Id: sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session := mapSessionToDTO(resp.GetSession())
+	return &session, nil
+}
+
+func (db *databaseService) ListSessions(ctx context.Context) ([]dto.Session, error) {
+	resp, err := db.client.ListSessions(ctx, &pb.ListSessionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]dto.Session, 0, len(resp.Sessions))
+	for _, s := range resp.Sessions {
+		sessions = append(sessions, mapSessionToDTO(s))
+	}
+
+	return sessions, nil
+}
+
+func (db *databaseService) RevokeSession(ctx context.Context, sessionID string) error {
+	_, err := db.client.RevokeSession(ctx, &pb.RevokeSessionRequest{
+		Id: sessionID,
+	})
+	return err
+}
+
+func (db *databaseService) RevokeAllSessions(ctx context.Context) error {
+	_, err := db.client.RevokeAllSessions(ctx, &pb.RevokeAllSessionsRequest{})
+	return err
+}
+
+func (db *databaseService) SwitchSessionWorkspace(ctx context.Context, sessionID, workspaceID string) (*dto.Session, error) {
+	resp, err := db.client.SwitchSessionWorkspace(ctx, &pb.SwitchSessionWorkspaceRequest{
+		Id:          sessionID,
+		WorkspaceId: workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session := mapSessionToDTO(resp.GetSession())
+	return &session, nil
+}
+
+func mapFiltersToPB(f dto.Filters) *pb.Filters {
+	taskStatuses := make([]pb.TaskStatus, 0, len(f.TaskStatuses))
+	for _, status := range f.TaskStatuses {
+		taskStatuses = append(taskStatuses, pb.TaskStatus(status))
+	}
+
+	taskPriorities := make([]pb.TaskPriority, 0, len(f.TaskPriorities))
+	for _, priority := range f.TaskPriorities {
+		taskPriorities = append(taskPriorities, pb.TaskPriority(priority))
+	}
+
+	return &pb.Filters{
+		TaskStatuses:       taskStatuses,
+		TaskPriorities:     taskPriorities,
+		DueBefore:          f.DueBefore,
+		DueAfter:           f.DueAfter,
+		CreatedBefore:      f.CreatedBefore,
+		CreatedAfter:       f.CreatedAfter,
+		OverdueOnly:        f.OverdueOnly,
+		HasDueDate:         f.HasDueDate,
+		HasEstimate:        f.HasEstimate,
+		MaxEstimateMinutes: f.MaxEstimateMinutes,
+		StoryPoints:        f.StoryPoints,
+		SharedWithMe:       f.SharedWithMe,
+		AssignedToMe:       f.AssignedToMe,
+		AssigneeId:         f.AssigneeID,
+		Timezone:           f.Timezone,
+	}
+}
+
+func mapOrderByToPB(o dto.OrderBy) *pb.OrderBy {
+	return &pb.OrderBy{
+		Field:     pb.SortField(o.Field),
+		Direction: pb.SortDirection(o.Direction),
+		Nulls:     pb.NullsOrder(o.Nulls),
+	}
+}
+
 func mapTaskToDTO(t *pb.Task) dto.Task {
 	return dto.Task{
-		ID:          t.Id,
-		UserID:      t.UserId,
-		Title:       t.Title,
-		Description: t.Description,
-		Status:      dto.TaskStatus(t.Status),
-		Priority:    dto.TaskPriority(t.Priority),
-		DueDate:     t.DueDate,
-		CreatedAt:   t.CreatedAt,
+		ID:              t.Id,
+		UserID:          t.UserId,
+		AssigneeID:      t.AssigneeId,
+		ParentID:        t.ParentId,
+		Rank:            t.Rank,
+		Title:           t.Title,
+		Description:     t.Description,
+		Status:          dto.TaskStatus(t.Status),
+		StatusCategory:  dto.StatusCategory(t.StatusCategory),
+		Priority:        dto.TaskPriority(t.Priority),
+		DueDate:         t.DueDate,
+		Project:         t.Project,
+		AllDay:          t.AllDay,
+		Overdue:         t.Overdue,
+		TrackedSeconds:  t.TrackedSeconds,
+		EstimateMinutes: t.EstimateMinutes,
+		StoryPoints:     t.StoryPoints,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+		StartedAt:       t.StartedAt,
+		CompletedAt:     t.CompletedAt,
+		Tags:            t.Tags,
+	}
+}
+
+func mapWorkflowToDTO(w *pb.Workflow) *dto.Workflow {
+	workflow := &dto.Workflow{
+		Statuses:    make([]dto.WorkflowStatus, 0, len(w.GetStatuses())),
+		Transitions: make([]dto.WorkflowTransition, 0, len(w.GetTransitions())),
+		IsDefault:   w.GetIsDefault(),
+	}
+
+	for _, status := range w.GetStatuses() {
+		workflow.Statuses = append(workflow.Statuses, dto.WorkflowStatus{
+			ID:       dto.TaskStatus(status.Id),
+			Name:     status.Name,
+			Category: dto.StatusCategory(status.Category),
+		})
+	}
+
+	for _, transition := range w.GetTransitions() {
+		workflow.Transitions = append(workflow.Transitions, dto.WorkflowTransition{
+			From: dto.TaskStatus(transition.From),
+			To:   dto.TaskStatus(transition.To),
+		})
+	}
+
+	return workflow
+}
+
+func (db *databaseService) StartTimer(ctx context.Context, req *dto.StartTimerRequest) (*dto.StartTimerResponse, error) {
+	resp, err := db.client.StartTimer(ctx, &pb.StartTimerRequest{
+		TaskId:  req.TaskID,
+		Project: req.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	started := &dto.StartTimerResponse{
+		Entry: mapTimeEntryToDTO(resp.Entry),
+	}
+	if resp.Stopped != nil {
+		stopped := mapTimeEntryToDTO(resp.Stopped)
+		started.Stopped = &stopped
+	}
+
+	return started, nil
+}
+
+func (db *databaseService) StopTimer(ctx context.Context) (*dto.TimeEntry, error) {
+	resp, err := db.client.StopTimer(ctx, &pb.StopTimerRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := mapTimeEntryToDTO(resp.Entry)
+	return &entry, nil
+}
+
+func (db *databaseService) AddTimeEntry(ctx context.Context, req *dto.AddTimeEntryRequest) (*dto.TimeEntry, error) {
+	resp, err := db.client.AddTimeEntry(ctx, &pb.AddTimeEntryRequest{
+		TaskId:    req.TaskID,
+		Project:   req.Project,
+		StartedAt: req.StartedAt,
+		StoppedAt: req.StoppedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := mapTimeEntryToDTO(resp.Entry)
+	return &entry, nil
+}
+
+func (db *databaseService) ListTimeEntries(ctx context.Context, req *dto.ListTimeEntriesRequest) ([]dto.TimeEntry, error) {
+	resp, err := db.client.ListTimeEntries(ctx, &pb.ListTimeEntriesRequest{
+		TaskId:   req.TaskID,
+		From:     req.From,
+		To:       req.To,
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]dto.TimeEntry, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		entries = append(entries, mapTimeEntryToDTO(entry))
+	}
+
+	return entries, nil
+}
+
+func (db *databaseService) GetTimeReport(ctx context.Context, req *dto.GetTimeReportRequest) (*dto.GetTimeReportResponse, error) {
+	resp, err := db.client.GetTimeReport(ctx, &pb.GetTimeReportRequest{
+		From:     req.From,
+		To:       req.To,
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.GetTimeReportResponse{
+		Rows:         make([]dto.TimeReportRow, 0, len(resp.Rows)),
+		TotalSeconds: resp.TotalSeconds,
+	}
+	for _, row := range resp.Rows {
+		report.Rows = append(report.Rows, dto.TimeReportRow{
+			Day:       row.Day,
+			TaskID:    row.TaskId,
+			TaskTitle: row.TaskTitle,
+			Project:   row.Project,
+			Seconds:   row.Seconds,
+		})
+	}
+
+	return report, nil
+}
+
+func (db *databaseService) ShareTask(ctx context.Context, req *dto.ShareTaskRequest) (*dto.Collaborator, error) {
+	resp, err := db.client.ShareTask(ctx, &pb.ShareTaskRequest{
+		TaskId:   req.TaskID,
+		Username: req.Username,
+		Role:     pb.TaskRole(req.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborator := mapCollaboratorToDTO(resp.Collaborator)
+	return &collaborator, nil
+}
+
+func (db *databaseService) UnshareTask(ctx context.Context, req *dto.UnshareTaskRequest) error {
+	_, err := db.client.UnshareTask(ctx, &pb.UnshareTaskRequest{
+		TaskId:   req.TaskID,
+		Username: req.Username,
+	})
+	return err
+}
+
+func (db *databaseService) ListCollaborators(ctx context.Context, taskID string) ([]dto.Collaborator, error) {
+	resp, err := db.client.ListCollaborators(ctx, &pb.ListCollaboratorsRequest{
+		TaskId: taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]dto.Collaborator, 0, len(resp.Collaborators))
+	for _, c := range resp.Collaborators {
+		collaborators = append(collaborators, mapCollaboratorToDTO(c))
+	}
+
+	return collaborators, nil
+}
+
+func (db *databaseService) AssignTask(ctx context.Context, req *dto.AssignTaskRequest) (*dto.Task, error) {
+	resp, err := db.client.AssignTask(ctx, &pb.AssignTaskRequest{
+		TaskId:   req.TaskID,
+		Username: req.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	task := mapTaskToDTO(resp.Task)
+	return &task, nil
+}
+
+func (db *databaseService) MoveTask(ctx context.Context, req *dto.MoveTaskRequest) (*dto.Task, error) {
+	var status *pb.TaskStatus
+	if req.Status != nil {
+		status = ptr(pb.TaskStatus(*req.Status))
+	}
+
+	resp, err := db.client.MoveTask(ctx, &pb.MoveTaskRequest{
+		Id:       req.ID,
+		BeforeId: req.BeforeID,
+		AfterId:  req.AfterID,
+		Status:   status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	task := mapTaskToDTO(resp.Task)
+	return &task, nil
+}
+
+func (db *databaseService) Undo(ctx context.Context) (*dto.UndoResponse, error) {
+	resp, err := db.client.Undo(ctx, &pb.UndoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUndoToDTO(resp.Operation, resp.Tasks, resp.DeletedTaskIds), nil
+}
+
+func (db *databaseService) Redo(ctx context.Context) (*dto.UndoResponse, error) {
+	resp, err := db.client.Redo(ctx, &pb.RedoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUndoToDTO(resp.Operation, resp.Tasks, resp.DeletedTaskIds), nil
+}
+
+func mapUndoToDTO(operation string, tasks []*pb.Task, deletedIDs []string) *dto.UndoResponse {
+	resp := &dto.UndoResponse{
+		Operation:      operation,
+		Tasks:          make([]dto.Task, 0, len(tasks)),
+		DeletedTaskIDs: deletedIDs,
+	}
+	for _, t := range tasks {
+		resp.Tasks = append(resp.Tasks, mapTaskToDTO(t))
+	}
+
+	return resp
+}
+
+func (db *databaseService) CreateTaskTemplate(ctx context.Context, req *dto.TaskTemplateRequest) (*dto.TaskTemplate, error) {
+	resp, err := db.client.CreateTaskTemplate(ctx, &pb.CreateTaskTemplateRequest{
+		Name:     req.Name,
+		Task:     mapTemplateItemToPB(req.Task),
+		Tags:     req.Tags,
+		Subtasks: mapTemplateItemsToPB(req.Subtasks),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	template := mapTemplateToDTO(resp.Template)
+	return &template, nil
+}
+
+func (db *databaseService) ListTaskTemplates(ctx context.Context) ([]dto.TaskTemplate, error) {
+	resp, err := db.client.ListTaskTemplates(ctx, &pb.ListTaskTemplatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]dto.TaskTemplate, 0, len(resp.Templates))
+	for _, t := range resp.Templates {
+		templates = append(templates, mapTemplateToDTO(t))
+	}
+
+	return templates, nil
+}
+
+func (db *databaseService) GetTaskTemplate(ctx context.Context, ID string) (*dto.TaskTemplate, error) {
+	resp, err := db.client.GetTaskTemplate(ctx, &pb.GetTaskTemplateRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	template := mapTemplateToDTO(resp.Template)
+	return &template, nil
+}
+
+func (db *databaseService) UpdateTaskTemplate(ctx context.Context, req *dto.TaskTemplateRequest) (*dto.TaskTemplate, error) {
+	resp, err := db.client.UpdateTaskTemplate(ctx, &pb.UpdateTaskTemplateRequest{
+		Id:       req.ID,
+		Name:     req.Name,
+		Task:     mapTemplateItemToPB(req.Task),
+		Tags:     req.Tags,
+		Subtasks: mapTemplateItemsToPB(req.Subtasks),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	template := mapTemplateToDTO(resp.Template)
+	return &template, nil
+}
+
+func (db *databaseService) DeleteTaskTemplate(ctx context.Context, ID string) error {
+	_, err := db.client.DeleteTaskTemplate(ctx, &pb.DeleteTaskTemplateRequest{
+		Id: ID,
+	})
+
+	return err
+}
+
+func (db *databaseService) InstantiateTemplate(ctx context.Context, req *dto.InstantiateTemplateRequest) ([]dto.Task, error) {
+	resp, err := db.client.InstantiateTemplate(ctx, &pb.InstantiateTemplateRequest{
+		TemplateId: req.TemplateID,
+		Variables:  req.Variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]dto.Task, 0, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		tasks = append(tasks, mapTaskToDTO(t))
+	}
+
+	return tasks, nil
+}
+
+func mapTemplateItemToPB(i dto.TaskTemplateItem) *pb.TaskTemplateItem {
+	return &pb.TaskTemplateItem{
+		Title:       i.Title,
+		Description: i.Description,
+		Priority:    pb.TaskPriority(i.Priority),
+		DueOffset:   i.DueOffset,
+	}
+}
+
+func mapTemplateItemsToPB(items []dto.TaskTemplateItem) []*pb.TaskTemplateItem {
+	subtasks := make([]*pb.TaskTemplateItem, 0, len(items))
+	for _, i := range items {
+		subtasks = append(subtasks, mapTemplateItemToPB(i))
+	}
+
+	return subtasks
+}
+
+func mapTemplateItemToDTO(i *pb.TaskTemplateItem) dto.TaskTemplateItem {
+	return dto.TaskTemplateItem{
+		Title:       i.GetTitle(),
+		Description: i.GetDescription(),
+		Priority:    dto.TaskPriority(i.GetPriority()),
+		DueOffset:   i.GetDueOffset(),
+	}
+}
+
+func mapTemplateToDTO(t *pb.TaskTemplate) dto.TaskTemplate {
+	template := dto.TaskTemplate{
+		ID:        t.Id,
+		Name:      t.Name,
+		Task:      mapTemplateItemToDTO(t.Task),
+		Tags:      t.Tags,
+		Subtasks:  make([]dto.TaskTemplateItem, 0, len(t.Subtasks)),
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+	for _, s := range t.Subtasks {
+		template.Subtasks = append(template.Subtasks, mapTemplateItemToDTO(s))
+	}
+
+	return template
+}
+
+func (db *databaseService) CreateWorkspace(ctx context.Context, req *dto.CreateWorkspaceRequest) (*dto.Workspace, error) {
+	resp, err := db.client.CreateWorkspace(ctx, &pb.CreateWorkspaceRequest{
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := mapWorkspaceToDTO(resp.Workspace)
+	return &workspace, nil
+}
+
+func (db *databaseService) ListWorkspaces(ctx context.Context) ([]dto.Workspace, error) {
+	resp, err := db.client.ListWorkspaces(ctx, &pb.ListWorkspacesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]dto.Workspace, 0, len(resp.Workspaces))
+	for _, w := range resp.Workspaces {
+		workspaces = append(workspaces, mapWorkspaceToDTO(w))
+	}
+
+	return workspaces, nil
+}
+
+func (db *databaseService) InviteToWorkspace(ctx context.Context, req *dto.InviteToWorkspaceRequest) (*dto.WorkspaceInvitation, error) {
+	resp, err := db.client.InviteToWorkspace(ctx, &pb.InviteToWorkspaceRequest{
+		WorkspaceId: req.WorkspaceID,
+		Username:    req.Username,
+		Role:        pb.WorkspaceRole(req.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := mapInvitationToDTO(resp.Invitation)
+	return &invitation, nil
+}
+
+func (db *databaseService) ListWorkspaceInvitations(ctx context.Context) ([]dto.WorkspaceInvitation, error) {
+	resp, err := db.client.ListWorkspaceInvitations(ctx, &pb.ListWorkspaceInvitationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]dto.WorkspaceInvitation, 0, len(resp.Invitations))
+	for _, i := range resp.Invitations {
+		invitations = append(invitations, mapInvitationToDTO(i))
+	}
+
+	return invitations, nil
+}
+
+// RespondToWorkspaceInvitation returns the joined workspace, nil if the invitation was declined.
+func (db *databaseService) RespondToWorkspaceInvitation(ctx context.Context, invitationID string, accept bool) (*dto.Workspace, error) {
+	resp, err := db.client.RespondToWorkspaceInvitation(ctx, &pb.RespondToWorkspaceInvitationRequest{
+		InvitationId: invitationID,
+		Accept:       accept,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Workspace == nil {
+		return nil, nil
+	}
+
+	workspace := mapWorkspaceToDTO(resp.Workspace)
+	return &workspace, nil
+}
+
+func (db *databaseService) LeaveWorkspace(ctx context.Context, workspaceID string) error {
+	_, err := db.client.LeaveWorkspace(ctx, &pb.LeaveWorkspaceRequest{
+		WorkspaceId: workspaceID,
+	})
+	return err
+}
+
+func mapWorkspaceToDTO(w *pb.Workspace) dto.Workspace {
+	return dto.Workspace{
+		ID:       w.GetId(),
+		Name:     w.GetName(),
+		Role:     dto.WorkspaceRole(w.GetRole()),
+		Personal: w.GetPersonal(),
+	}
+}
+
+func mapSessionToDTO(s *pb.Session) dto.Session {
+	return dto.Session{
+		ID:              s.GetId(),
+		UserID:          s.GetUserId(),
+		WorkspaceID:     s.GetWorkspaceId(),
+		Device:          s.GetDevice(),
+		IP:              s.GetIp(),
+		CreatedAt:       s.GetCreatedAt(),
+		LastRefreshedAt: s.GetLastRefreshedAt(),
+		ExpiresAt:       s.GetExpiresAt(),
+	}
+}
+
+func mapInvitationToDTO(i *pb.WorkspaceInvitation) dto.WorkspaceInvitation {
+	return dto.WorkspaceInvitation{
+		ID:            i.GetId(),
+		WorkspaceID:   i.GetWorkspaceId(),
+		WorkspaceName: i.GetWorkspaceName(),
+		InvitedBy:     i.GetInvitedBy(),
+		Role:          dto.WorkspaceRole(i.GetRole()),
+		CreatedAt:     i.GetCreatedAt(),
+	}
+}
+
+func mapCollaboratorToDTO(c *pb.Collaborator) dto.Collaborator {
+	return dto.Collaborator{
+		UserID:   c.GetUserId(),
+		Username: c.GetUsername(),
+		Role:     dto.TaskRole(c.GetRole()),
+	}
+}
+
+func mapTimeEntryToDTO(e *pb.TimeEntry) dto.TimeEntry {
+	return dto.TimeEntry{
+		ID:        e.GetId(),
+		TaskID:    e.GetTaskId(),
+		Project:   e.GetProject(),
+		StartedAt: e.GetStartedAt(),
+		StoppedAt: e.GetStoppedAt(),
+		Seconds:   e.GetSeconds(),
 	}
 }
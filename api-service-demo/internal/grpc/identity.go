@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const identityMetadataKey = "x-identity-assertion"
+
+type userIDKey struct{}
+
+type workspaceIDKey struct{}
+
+// WithUserID marks the context so that calls made with it
+// are sent to database-service on behalf of the user.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// WithWorkspaceID marks the context so that calls made with it work
+// in the workspace, the user's personal workspace if it's not set.
+func WithWorkspaceID(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, workspaceIDKey{}, workspaceID)
+}
+
+// IdentityInterceptor attaches a signed identity assertion
+// for the user set by WithUserID to every outgoing call.
+func IdentityInterceptor(signer token.AssertionSigner) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := withAssertion(ctx, signer)
+		if err != nil {
+			return err
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// IdentityStreamInterceptor is IdentityInterceptor for streaming calls.
+func IdentityStreamInterceptor(signer token.AssertionSigner) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := withAssertion(ctx, signer)
+		if err != nil {
+			return nil, err
+		}
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func withAssertion(ctx context.Context, signer token.AssertionSigner) (context.Context, error) {
+	userID, ok := ctx.Value(userIDKey{}).(string)
+	if !ok || userID == "" {
+		return ctx, nil
+	}
+
+	workspaceID, _ := ctx.Value(workspaceIDKey{}).(string)
+	assertion, err := signer.Sign(userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, identityMetadataKey, assertion), nil
+}
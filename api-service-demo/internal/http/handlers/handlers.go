@@ -1,15 +1,30 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/braunkc/todo-app/api-service-demo/internal/csvtasks"
 	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
 	client "github.com/braunkc/todo-app/api-service-demo/internal/grpc"
+	"github.com/braunkc/todo-app/api-service-demo/internal/http/middlewares"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ical"
+	"github.com/braunkc/todo-app/api-service-demo/internal/importers"
+	"github.com/braunkc/todo-app/api-service-demo/internal/quickadd"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ratelimit"
+	"github.com/braunkc/todo-app/api-service-demo/internal/todotxt"
 	"github.com/braunkc/todo-app/api-service-demo/internal/token"
 	"github.com/gin-gonic/gin"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Register(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
@@ -36,22 +51,22 @@ func Register(jwtService token.JWTService, dbService client.DatabaseService) gin
 			Password: password,
 		})
 		if err != nil {
-			c.AbortWithStatus(http.StatusInternalServerError)
+			abortWithError(c, err)
 			return
 		}
 
-		token, err := jwtService.Generate(resp.User.ID)
-		if err != nil {
+		if err := startSession(ctx, c, jwtService, dbService, resp.User.ID); err != nil {
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
 
-		c.SetCookie("Authorization", token, 3600*24*7, "/", "", false, true)
 		c.Redirect(http.StatusSeeOther, "/tasks")
 	}
 }
 
-func Login(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
+// Login slows down repeated failures on an account and locks it out for a while after
+// too many, see ratelimit.LockoutPolicy. Locked out attempts get 429 with Retry-After.
+func Login(jwtService token.JWTService, dbService client.DatabaseService, lockout ratelimit.Lockout) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		username := c.PostForm("username")
 		password := c.PostForm("password")
@@ -64,30 +79,203 @@ func Login(jwtService token.JWTService, dbService client.DatabaseService) gin.Ha
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
 
-		user, err := dbService.Authenticate(ctx, username, password)
+		wait, err := lockout.Check(ctx, username)
 		if err != nil {
-			c.Abort()
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		if wait > 0 {
+			c.Header("Retry-After", ratelimit.RetryAfter(wait))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many failed logins"})
 			return
 		}
 
-		token, err := jwtService.Generate(user.ID)
+		user, err := dbService.Authenticate(ctx, username, password)
 		if err != nil {
+			// only wrong credentials count towards the lockout
+			if status.Code(err) == codes.Unauthenticated {
+				if _, err := lockout.Fail(ctx, username); err != nil {
+					c.AbortWithStatus(http.StatusInternalServerError)
+					return
+				}
+			}
+
+			abortWithError(c, err)
+			return
+		}
+
+		if err := lockout.Reset(ctx, username); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		if err := startSession(ctx, c, jwtService, dbService, user.ID); err != nil {
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
 
-		c.SetCookie("Authorization", token, 3600*24*7, "/", "", false, true)
 		c.Redirect(http.StatusSeeOther, "/tasks")
 	}
 }
 
-func Logout() gin.HandlerFunc {
+// startSession starts a session of the user on the client's device and sets its tokens as cookies.
+func startSession(ctx context.Context, c *gin.Context, jwtService token.JWTService,
+	dbService client.DatabaseService, userID string) error {
+	session, err := dbService.CreateSession(client.WithUserID(ctx, userID), &dto.CreateSessionRequest{
+		Device: c.Request.UserAgent(),
+		IP:     c.ClientIP(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = middlewares.IssueTokens(c, jwtService, session)
+	return err
+}
+
+// RefreshToken exchanges the refresh token of the body or the Refresh cookie for a new access token
+// and the next refresh token. Every refresh token works once, reusing one revokes its session.
+func RefreshToken(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.RefreshSessionRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		if req.RefreshToken == "" {
+			req.RefreshToken, _ = c.Cookie(middlewares.RefreshCookie)
+		}
+
+		if req.RefreshToken == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing refresh token"})
+			return
+		}
+		req.Device = c.Request.UserAgent()
+		req.IP = c.ClientIP()
+
+		session, err := dbService.RefreshSession(c.Request.Context(), &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		tokens, err := middlewares.IssueTokens(c, jwtService, session)
+		if err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.JSON(http.StatusOK, tokens)
+	}
+}
+
+// abortWithError answers a failed call to database-service with the status its code maps to.
+// Internal and unexpected errors are answered without their message.
+func abortWithError(c *gin.Context, err error) {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+	case codes.Unauthenticated:
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case codes.PermissionDenied:
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": st.Message()})
+	case codes.NotFound:
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": st.Message()})
+	default:
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// Logout revokes the session the request is made in.
+func Logout(dbService client.DatabaseService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.SetCookie("Authorization", "", -1, "/", "", false, true)
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.RevokeSession(ctx, c.GetString("session_id")); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		middlewares.ClearSessionCookies(c)
 		c.JSON(http.StatusOK, nil)
 	}
 }
 
+// ListSessions lists the user's sessions, the last refreshed first.
+func ListSessions(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		sessions, err := dbService.ListSessions(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		for i := range sessions {
+			sessions[i].Current = sessions[i].ID == c.GetString("session_id")
+		}
+
+		c.JSON(http.StatusOK, gin.H{"sessions": sessions})
+	}
+}
+
+// RevokeSession logs the user out on the session's device, it takes effect on its next request.
+func RevokeSession(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.RevokeSession(ctx, c.Param("id")); err != nil {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+
+		if c.Param("id") == c.GetString("session_id") {
+			middlewares.ClearSessionCookies(c)
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// RevokeAllSessions logs the user out everywhere, this session included.
+func RevokeAllSessions(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.RevokeAllSessions(ctx); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		middlewares.ClearSessionCookies(c)
+		c.Status(http.StatusNoContent)
+	}
+}
+
 func DeleteUser(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID, exists := c.Get("user_id")
@@ -96,18 +284,19 @@ func DeleteUser(jwtService token.JWTService, dbService client.DatabaseService) g
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		ctx, cancel := context.WithTimeout(client.WithUserID(context.Background(), userID.(string)), 3*time.Second)
 		defer cancel()
 
 		_, err := dbService.DeleteUserByID(ctx, &dto.DeleteUserByIDRequest{
 			ID: userID.(string),
 		})
 		if err != nil {
-			c.AbortWithStatus(http.StatusInternalServerError)
+			abortWithError(c, err)
 			return
 		}
 
-		c.SetCookie("Authorization", "", 0, "/", "", false, true)
+		// the user's sessions are deleted with it
+		middlewares.ClearSessionCookies(c)
 		c.Redirect(http.StatusSeeOther, "/landing")
 	}
 }
@@ -126,15 +315,10 @@ func CreateTask(dbService client.DatabaseService) gin.HandlerFunc {
 			return
 		}
 
-		md := metadata.New(map[string]string{
-			"userID": userID.(string),
-		})
-
-		ctx := c.Request.Context()
-		ctx = metadata.NewOutgoingContext(ctx, md)
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
 		task, err := dbService.CreateTask(ctx, &req)
 		if err != nil {
-			c.AbortWithStatus(http.StatusInternalServerError)
+			abortWithError(c, err)
 			return
 		}
 
@@ -142,9 +326,11 @@ func CreateTask(dbService client.DatabaseService) gin.HandlerFunc {
 	}
 }
 
-func UpdateTask(dbService client.DatabaseService) gin.HandlerFunc {
+// QuickAddTask creates a task from a line of text, dates in it are in the user's timezone.
+// In preview it returns what it parsed without creating anything.
+func QuickAddTask(dbService client.DatabaseService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req dto.UpdateTaskRequest
+		var req dto.QuickAddRequest
 		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
 			c.AbortWithStatus(http.StatusBadRequest)
 			return
@@ -156,28 +342,50 @@ func UpdateTask(dbService client.DatabaseService) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := dbService.GetTask(context.Background(), &dto.GetTaskRequest{
-			ID: req.ID,
-		})
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		loc, err := userLocation(ctx, dbService)
 		if err != nil {
-			c.AbortWithStatus(http.StatusBadRequest)
+			abortWithError(c, err)
+			return
+		}
+
+		resp := dto.QuickAddResponse{
+			Parsed: quickadd.Parse(req.Text, time.Now().In(loc)),
+		}
+		if req.Preview {
+			c.JSON(http.StatusOK, resp)
+			return
+		}
+
+		created, err := dbService.CreateTask(ctx, &resp.Parsed.Task)
+		if err != nil {
+			abortWithError(c, err)
 			return
 		}
+		resp.Task = &created.Task
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
 
-		if resp.Task.UserID != userID {
+func UpdateTask(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.UpdateTaskRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
 			c.AbortWithStatus(http.StatusBadRequest)
 			return
 		}
 
-		md := metadata.New(map[string]string{
-			"userID": userID.(string),
-		})
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
 
-		ctx := c.Request.Context()
-		ctx = metadata.NewOutgoingContext(ctx, md)
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
 		task, err := dbService.UpdateTask(ctx, &req)
 		if err != nil {
-			c.AbortWithStatus(http.StatusInternalServerError)
+			abortWithError(c, err)
 			return
 		}
 
@@ -199,36 +407,122 @@ func DeleteTask(dbService client.DatabaseService) gin.HandlerFunc {
 			return
 		}
 
-		for _, taskID := range req.IDs {
-			resp, err := dbService.GetTask(context.Background(), &dto.GetTaskRequest{
-				ID: taskID,
-			})
-			if err != nil {
-				c.AbortWithStatus(http.StatusInternalServerError)
-				return
-			}
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		_, err := dbService.DeleteTasksByID(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+	}
+}
 
-			if resp.Task.UserID != userID.(string) {
-				c.AbortWithStatus(http.StatusBadRequest)
-				return
-			}
+// ShareTask shares a task with a user or changes their role.
+func ShareTask(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.ShareTaskRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		req.TaskID = c.Param("id")
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		collaborator, err := dbService.ShareTask(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
 		}
 
-		_, err := dbService.DeleteTasksByID(context.Background(), &req)
+		c.JSON(http.StatusOK, collaborator)
+	}
+}
+
+func UnshareTask(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		err := dbService.UnshareTask(ctx, &dto.UnshareTaskRequest{
+			TaskID:   c.Param("id"),
+			Username: c.Param("username"),
+		})
 		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ListCollaborators lists the task's owner and the users it's shared with.
+func ListCollaborators(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		collaborators, err := dbService.ListCollaborators(ctx, c.Param("id"))
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"collaborators": collaborators})
 	}
 }
 
-func GetTasks(dbService client.DatabaseService) gin.HandlerFunc {
+// Undo reverts the user's last task operation in the active workspace.
+func Undo(dbService client.DatabaseService) gin.HandlerFunc {
+	return replay(dbService.Undo)
+}
+
+// Redo applies the task operation the user undid last again.
+func Redo(dbService client.DatabaseService) gin.HandlerFunc {
+	return replay(dbService.Redo)
+}
+
+func replay(do func(ctx context.Context) (*dto.UndoResponse, error)) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req dto.GetTasksRequest
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := do(ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// MoveTask places a task between two others of its list, optionally in another status column.
+func MoveTask(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.MoveTaskRequest
 		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
 			c.AbortWithStatus(http.StatusBadRequest)
 			return
 		}
+		req.ID = c.Param("id")
 
 		userID, exists := c.Get("user_id")
 		if !exists {
@@ -236,22 +530,1444 @@ func GetTasks(dbService client.DatabaseService) gin.HandlerFunc {
 			return
 		}
 
-		md := metadata.New(map[string]string{
-			"userID": userID.(string),
-		})
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		task, err := dbService.MoveTask(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
 
-		ctx := c.Request.Context()
-		ctx = metadata.NewOutgoingContext(ctx, md)
-		resp, err := dbService.GetTasks(ctx, &req)
+		c.JSON(http.StatusOK, task)
+	}
+}
+
+// AssignTask assigns a task to a member of its workspace, an empty username unassigns it.
+func AssignTask(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AssignTaskRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		req.TaskID = c.Param("id")
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		task, err := dbService.AssignTask(ctx, &req)
 		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, task)
+	}
+}
+
+func BulkUpdateTasks(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.BulkUpdateTasksRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
 
+		// ownership is checked by database-service, it only selects the caller's tasks
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.BulkUpdateTasks(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
 		c.JSON(http.StatusOK, resp)
 	}
 }
 
+func GetTasks(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.GetTasksRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.GetTasks(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// GetTaskStats returns task counts and completions per day or week.
+// Query params: from=2024-01-01 to=2024-01-31 bucket=0 (day) or 1 (week) timezone=Europe/Berlin
+func GetTaskStats(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.GetTaskStatsRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.GetTaskStats(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func GetUserSettings(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		settings, err := dbService.GetUserSettings(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, settings)
+	}
+}
+
+func UpdateUserSettings(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.UserSettings
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		settings, err := dbService.UpdateUserSettings(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, settings)
+	}
+}
+
+func GetWorkflow(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workflow, err := dbService.GetWorkflow(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, workflow)
+	}
+}
+
+// UpdateWorkflow replaces the user's workflow. Invalid workflows and removing
+// statuses that still have tasks are rejected with the reason in the body.
+func UpdateWorkflow(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.UpdateWorkflowRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workflow, err := dbService.UpdateWorkflow(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, workflow)
+	}
+}
+
+func ListTaskTemplates(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		templates, err := dbService.ListTaskTemplates(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"templates": templates})
+	}
+}
+
+func CreateTaskTemplate(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.TaskTemplateRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		template, err := dbService.CreateTaskTemplate(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, template)
+	}
+}
+
+func GetTaskTemplate(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		template, err := dbService.GetTaskTemplate(ctx, c.Param("id"))
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, template)
+	}
+}
+
+// UpdateTaskTemplate replaces everything the template captures.
+func UpdateTaskTemplate(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.TaskTemplateRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		req.ID = c.Param("id")
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		template, err := dbService.UpdateTaskTemplate(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, template)
+	}
+}
+
+func DeleteTaskTemplate(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.DeleteTaskTemplate(ctx, c.Param("id")); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// InstantiateTemplate creates the template's task and its subtasks in the active workspace.
+func InstantiateTemplate(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.InstantiateTemplateRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		req.TemplateID = c.Param("id")
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		tasks, err := dbService.InstantiateTemplate(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{"tasks": tasks})
+	}
+}
+
+// ListWorkspaces lists the user's workspaces and marks the one the token works in.
+func ListWorkspaces(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workspaces, err := dbService.ListWorkspaces(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		active := c.GetString("workspace_id")
+		for i := range workspaces {
+			workspaces[i].Active = workspaces[i].ID == active || (active == "" && workspaces[i].Personal)
+		}
+
+		c.JSON(http.StatusOK, gin.H{"workspaces": workspaces})
+	}
+}
+
+func CreateWorkspace(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.CreateWorkspaceRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workspace, err := dbService.CreateWorkspace(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, workspace)
+	}
+}
+
+// SwitchWorkspace makes the session work in another workspace of the user and issues
+// a new access token for it, the tokens the session is refreshed with work there too.
+func SwitchWorkspace(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workspaces, err := dbService.ListWorkspaces(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		for _, workspace := range workspaces {
+			if workspace.ID != c.Param("id") {
+				continue
+			}
+
+			workspaceID := workspace.ID
+			if workspace.Personal {
+				workspaceID = ""
+			}
+
+			sessionID := c.GetString("session_id")
+			if _, err := dbService.SwitchSessionWorkspace(ctx, sessionID, workspaceID); err != nil {
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+
+			token, err := jwtService.Generate(userID.(string), workspaceID, sessionID)
+			if err != nil {
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+
+			workspace.Active = true
+			middlewares.SetAccessCookie(c, token)
+			c.JSON(http.StatusOK, gin.H{"token": token, "workspace": workspace})
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "not found"})
+	}
+}
+
+// InviteToWorkspace invites a user to the workspace, the user accepts or declines the invitation.
+func InviteToWorkspace(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.InviteToWorkspaceRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		req.WorkspaceID = c.Param("id")
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		invitation, err := dbService.InviteToWorkspace(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, invitation)
+	}
+}
+
+func ListWorkspaceInvitations(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		invitations, err := dbService.ListWorkspaceInvitations(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"invitations": invitations})
+	}
+}
+
+// RespondToWorkspaceInvitation accepts or declines an invitation of the user.
+// Accepting doesn't switch to the joined workspace.
+func RespondToWorkspaceInvitation(dbService client.DatabaseService, accept bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		workspace, err := dbService.RespondToWorkspaceInvitation(ctx, c.Param("id"), accept)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		if workspace == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, workspace)
+	}
+}
+
+// LeaveWorkspace removes the user from the workspace. Leaving the workspace
+// the session works in switches it back to the personal workspace.
+func LeaveWorkspace(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.LeaveWorkspace(ctx, c.Param("id")); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+
+		if c.GetString("workspace_id") == c.Param("id") {
+			sessionID := c.GetString("session_id")
+			if _, err := dbService.SwitchSessionWorkspace(ctx, sessionID, ""); err != nil {
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+
+			token, err := jwtService.Generate(userID.(string), "", sessionID)
+			if err != nil {
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			middlewares.SetAccessCookie(c, token)
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// StartTimer starts a timer on a task, stopping the user's running timer.
+func StartTimer(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.StartTimerRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.StartTimer(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func StopTimer(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		entry, err := dbService.StopTimer(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, entry)
+	}
+}
+
+// AddTimeEntry adds a finished entry, e.g. time that wasn't tracked with a timer.
+func AddTimeEntry(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AddTimeEntryRequest
+		if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		entry, err := dbService.AddTimeEntry(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, entry)
+	}
+}
+
+// ListTimeEntries returns the time entries in a range, newest first.
+// Query params: task_id=... from=2024-01-01 to=2024-01-31 timezone=Europe/Berlin
+func ListTimeEntries(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.ListTimeEntriesRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		entries, err := dbService.ListTimeEntries(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"entries": entries})
+	}
+}
+
+// GetTimeReport returns the tracked time per day, task and project.
+// Takes the ListTimeEntries params except task_id, format=csv downloads it as CSV.
+func GetTimeReport(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.GetTimeReportRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		report, err := dbService.GetTimeReport(ctx, &req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		if c.Query("format") != "csv" {
+			c.JSON(http.StatusOK, report)
+			return
+		}
+
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="time-report.csv"`)
+		c.Status(http.StatusOK)
+		_ = csvtasks.WriteTimeReport(c.Writer, report)
+	}
+}
+
+// ExportTasksCSV writes all tasks matching the query filters as CSV.
+// Query params: statuses=0,1 priorities=2 title=... sort_field=1 sort_direction=1 sort_keys=1:0:0,3
+// due_before=2024-01-31 due_after=... created_before=... created_after=...
+// overdue_only=true has_due_date=false has_estimate=true max_estimate_minutes=60
+// story_points=1,2,3 shared_with_me=true assigned_to_me=true assignee=<user id> timezone=Europe/Berlin
+func ExportTasksCSV(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		req, err := parseTasksQuery(c)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+
+		// headers are written after the first page so an early error still gets a status code
+		var w *csvtasks.Writer
+		err = forEachTasksPage(ctx, dbService, req, func(tasks []dto.Task) error {
+			if w == nil {
+				c.Header("Content-Type", "text/csv; charset=utf-8")
+				c.Header("Content-Disposition", `attachment; filename="tasks.csv"`)
+				c.Status(http.StatusOK)
+
+				if w, err = csvtasks.NewWriter(c.Writer); err != nil {
+					return err
+				}
+			}
+
+			return w.Write(tasks)
+		})
+		if err != nil && w == nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		if w != nil {
+			_ = w.Flush()
+		}
+	}
+}
+
+// ExportTasksICS writes all tasks matching the query filters as an iCalendar file.
+// Besides the ExportTasksCSV params it accepts components=todo|event|both.
+func ExportTasksICS(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		req, err := parseTasksQuery(c)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		writeCalendar(ctx, c, dbService, req, "attachment")
+	}
+}
+
+// CalendarFeed serves the calendar of the feed token owner. It doesn't use cookie auth
+// so calendar apps can subscribe to it, the secret token in the URL is the credential.
+func CalendarFeed(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		feedToken := strings.TrimSuffix(c.Param("token"), ".ics")
+
+		feed, err := dbService.ResolveCalendarFeed(c.Request.Context(), &dto.ResolveCalendarFeedRequest{
+			Token: feedToken,
+		})
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		req, err := parseTasksQuery(c)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), feed.UserID)
+		writeCalendar(ctx, c, dbService, req, "inline")
+	}
+}
+
+func CreateCalendarFeed(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.CreateCalendarFeed(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		scheme := "http"
+		if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+			scheme = "https"
+		}
+		resp.URL = scheme + "://" + c.Request.Host + "/calendar/" + resp.Token + ".ics"
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+func RevokeCalendarFeed(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		if err := dbService.RevokeCalendarFeed(ctx); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func writeCalendar(ctx context.Context, c *gin.Context, dbService client.DatabaseService, req dto.GetTasksRequest, disposition string) {
+	var components ical.Component
+	switch c.DefaultQuery("components", "todo") {
+	case "todo":
+		components = ical.VTodo
+	case "event":
+		components = ical.VEvent
+	case "both":
+		components = ical.VTodo | ical.VEvent
+	default:
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var tasks []dto.Task
+	if err := forEachTasksPage(ctx, dbService, req, func(page []dto.Task) error {
+		tasks = append(tasks, page...)
+		return nil
+	}); err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Content-Disposition", disposition+`; filename="tasks.ics"`)
+	c.Status(http.StatusOK)
+	_ = ical.Write(c.Writer, "todo-app tasks", tasks, components)
+}
+
+// forEachTasksPage calls fn for every page of tasks matching req.
+func forEachTasksPage(ctx context.Context, dbService client.DatabaseService, req dto.GetTasksRequest, fn func(tasks []dto.Task) error) error {
+	req.PageSize = 1000
+	for req.PageNumber = 1; ; req.PageNumber++ {
+		resp, err := dbService.GetTasks(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(resp.Tasks); err != nil {
+			return err
+		}
+
+		if req.PageNumber >= resp.TotalPages {
+			return nil
+		}
+	}
+}
+
+const maxImportSize = 10 << 20
+
+// ImportTasks imports tasks from CSV sent either as the "file" field of a multipart form
+// or as the raw request body. "mapping" is a JSON object of task field to column name
+// and "dry_run=true" validates the rows without creating tasks.
+func ImportTasks(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+		dryRun := c.Query("dry_run") == "true"
+		mappingJSON := c.Query("mapping")
+		var file io.Reader = c.Request.Body
+		if strings.HasPrefix(c.ContentType(), "multipart/") {
+			fileHeader, err := c.FormFile("file")
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+				return
+			}
+
+			f, err := fileHeader.Open()
+			if err != nil {
+				c.AbortWithStatus(http.StatusBadRequest)
+				return
+			}
+			defer f.Close()
+			file = f
+
+			if c.PostForm("dry_run") == "true" {
+				dryRun = true
+			}
+			if m := c.PostForm("mapping"); m != "" {
+				mappingJSON = m
+			}
+		}
+
+		mapping := csvtasks.DefaultMapping()
+		if mappingJSON != "" {
+			if err := json.Unmarshal([]byte(mappingJSON), &mapping); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid mapping"})
+				return
+			}
+		}
+
+		rows, err := csvtasks.ReadRows(file, mapping)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+		resp, err := dbService.ImportTasks(ctx, &dto.ImportTasksRequest{
+			DryRun: dryRun,
+			Rows:   rows,
+		})
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// ImportFromSource imports an export of another tool (trello, todoist or taskwarrior)
+// and reports the items that were skipped by the importer along with the row results.
+func ImportFromSource(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		source := c.Param("source")
+		importer, ok := importers.Get(source)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "unsupported source"})
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+		dryRun := c.Query("dry_run") == "true"
+		var file io.Reader = c.Request.Body
+		if strings.HasPrefix(c.ContentType(), "multipart/") {
+			fileHeader, err := c.FormFile("file")
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+				return
+			}
+
+			f, err := fileHeader.Open()
+			if err != nil {
+				c.AbortWithStatus(http.StatusBadRequest)
+				return
+			}
+			defer f.Close()
+			file = f
+
+			if c.PostForm("dry_run") == "true" {
+				dryRun = true
+			}
+		}
+
+		result, err := importer.Import(file)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		report := dto.ImportReport{
+			Source:       strings.ToLower(source),
+			Skipped:      result.Skipped,
+			SkippedCount: int64(len(result.Skipped)),
+			Notes:        result.Notes,
+		}
+		report.DryRun = dryRun
+
+		if len(result.Rows) > 0 {
+			ctx := client.WithUserID(c.Request.Context(), userID.(string))
+			resp, err := dbService.ImportTasks(ctx, &dto.ImportTasksRequest{
+				DryRun: dryRun,
+				Rows:   result.Rows,
+			})
+			if err != nil {
+				abortWithError(c, err)
+				return
+			}
+			report.ImportTasksResponse = *resp
+		}
+
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// ExportTodoTxt writes all tasks matching the query filters in the todo.txt format.
+func ExportTodoTxt(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		req, err := parseTasksQuery(c)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+
+		loc, err := userLocation(ctx, dbService)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		var items []todotxt.Item
+		if err := forEachTasksPage(ctx, dbService, req, func(tasks []dto.Task) error {
+			for _, task := range tasks {
+				items = append(items, todotxt.FromTask(task, loc))
+			}
+			return nil
+		}); err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		var buf bytes.Buffer
+		_ = todotxt.Write(&buf, items)
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", buf.Bytes())
+	}
+}
+
+// SyncTodoTxt merges an uploaded todo.txt file (request body) with the user's tasks
+// and returns the merged file.
+//
+// Lines with an id: are matched with tasks and merged one by one, see todoTxtLineWins.
+// Lines without an id: create tasks, lines that can't be created are returned
+// unchanged and counted in X-Sync-Failed. Tasks missing from the file are kept.
+func SyncTodoTxt(dbService client.DatabaseService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		lastModified, err := strconv.ParseInt(c.Query("last_modified"), 10, 64)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "last_modified is required"})
+			return
+		}
+
+		items, err := todotxt.Parse(http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize))
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		ctx := client.WithUserID(c.Request.Context(), userID.(string))
+
+		workflow, err := dbService.GetWorkflow(ctx)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		loc, err := userLocation(ctx, dbService)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		tasks, err := allTasks(ctx, dbService)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		byID := make(map[string]dto.Task, len(tasks))
+		for _, task := range tasks {
+			byID[task.ID] = task
+		}
+
+		var failed []todotxt.Item
+		for _, item := range items {
+			if item.ID == "" {
+				if err := createFromTodoTxt(ctx, dbService, workflow, item); err != nil {
+					failed = append(failed, item)
+				}
+				continue
+			}
+
+			// tasks deleted on the server are dropped from the file
+			task, ok := byID[item.ID]
+			if !ok || !todoTxtLineWins(item, task, lastModified) {
+				continue
+			}
+
+			if req := todoTxtPatch(task, item, workflow, loc); req != nil {
+				if _, err := dbService.UpdateTask(ctx, req); err != nil {
+					failed = append(failed, item)
+				}
+			}
+		}
+
+		tasks, err = allTasks(ctx, dbService)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		merged := make([]todotxt.Item, 0, len(tasks)+len(failed))
+		for _, task := range tasks {
+			merged = append(merged, todotxt.FromTask(task, loc))
+		}
+		merged = append(merged, failed...)
+
+		var buf bytes.Buffer
+		_ = todotxt.Write(&buf, merged)
+		c.Header("X-Sync-Failed", strconv.Itoa(len(failed)))
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", buf.Bytes())
+	}
+}
+
+// todoTxtLineWins reports whether a line with an id: overrides its task.
+// Lines written by the server carry the task's updated_at in rev:. If the task
+// hasn't changed since, only the line may have, so it wins. If both changed,
+// the task wins unless the line was completed on a later day (its done date).
+// Lines without rev: win when the file's last_modified (unix seconds)
+// is newer than the task's updated_at.
+func todoTxtLineWins(item todotxt.Item, task dto.Task, lastModified int64) bool {
+	if item.Revision == 0 {
+		return lastModified > task.UpdatedAt
+	}
+
+	if item.Revision >= task.UpdatedAt {
+		return true
+	}
+
+	return item.Done && !item.DoneDate.IsZero() && item.DoneDate.Unix() > task.UpdatedAt
+}
+
+// createFromTodoTxt creates the task of a new line. Tasks can't be created done,
+// so the status is set right after, and a task that can't be finished is deleted
+// again: the line is reported failed and would be created twice otherwise.
+func createFromTodoTxt(ctx context.Context, dbService client.DatabaseService, workflow *dto.Workflow, item todotxt.Item) error {
+	done, ok := firstStatusIn(workflow, dto.StatusCategoryDone)
+	if item.Done && !ok {
+		return errNoDoneStatus
+	}
+
+	resp, err := dbService.CreateTask(ctx, &dto.CreateTaskRequest{
+		Title:    item.Text,
+		Priority: todotxt.ToTaskPriority(item.Priority),
+		DueDate:  item.DueUnix(),
+		Project:  item.Project,
+		AllDay:   true,
+		Tags:     item.Tags(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !item.Done {
+		return nil
+	}
+
+	if _, err := dbService.UpdateTask(ctx, &dto.UpdateTaskRequest{
+		ID:     resp.Task.ID,
+		Status: &done,
+	}); err != nil {
+		_, _ = dbService.DeleteTasksByID(ctx, &dto.DeleteTasksByIDRequest{
+			IDs: []string{resp.Task.ID},
+		})
+		return err
+	}
+
+	return nil
+}
+
+// todoTxtPatch returns the changes the line makes to the task, nil if there are none.
+// Done lines move the task to the workflow's first done status, reopened lines to its initial status.
+func todoTxtPatch(task dto.Task, item todotxt.Item, workflow *dto.Workflow, loc *time.Location) *dto.UpdateTaskRequest {
+	req := dto.UpdateTaskRequest{
+		ID: task.ID,
+	}
+	changed := false
+
+	if item.Text != task.Title {
+		req.Title = &item.Text
+		changed = true
+	}
+
+	// the project was written as its tag, so an unchanged tag keeps the project as it is
+	if item.Project != todotxt.ProjectTag(task.Project) {
+		req.Project = &item.Project
+		changed = true
+	}
+
+	if tags := item.Tags(); !slices.Equal(tags, task.Tags) {
+		req.Tags = &tags
+		changed = true
+	}
+
+	if item.Priority != 0 {
+		if priority := todotxt.ToTaskPriority(item.Priority); priority != task.Priority {
+			req.Priority = &priority
+			changed = true
+		}
+	}
+
+	// todo.txt has no "in progress", so an open line only reopens done tasks
+	isDone := task.StatusCategory == dto.StatusCategoryDone
+	if done, ok := firstStatusIn(workflow, dto.StatusCategoryDone); ok && item.Done && !isDone {
+		req.Status = &done
+		changed = true
+	} else if !item.Done && isDone && len(workflow.Statuses) > 0 {
+		reopened := workflow.Statuses[0].ID
+		req.Status = &reopened
+		changed = true
+	}
+
+	// the line's date is compared with the date the task was written with,
+	// a changed date makes the task all-day and a removed one clears it
+	if !item.DueDate.IsZero() {
+		current := todotxt.FromTask(task, loc).DueDate.Format(time.DateOnly)
+		if task.DueDate == 0 || current != item.DueDate.Format(time.DateOnly) {
+			dueDate, allDay := item.DueUnix(), true
+			req.DueDate = &dueDate
+			req.AllDay = &allDay
+			changed = true
+		}
+	} else if task.DueDate != 0 {
+		var dueDate int64
+		req.DueDate = &dueDate
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return &req
+}
+
+// userLocation returns the location of the user's timezone, UTC if it's not set.
+func userLocation(ctx context.Context, dbService client.DatabaseService) (*time.Location, error) {
+	settings, err := dbService.GetUserSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(settings.Timezone)
+	if err != nil {
+		return time.UTC, nil
+	}
+
+	return loc, nil
+}
+
+var errNoDoneStatus = errors.New("workflow has no done status")
+
+// firstStatusIn returns the first status of the workflow in the category.
+func firstStatusIn(workflow *dto.Workflow, category dto.StatusCategory) (dto.TaskStatus, bool) {
+	for _, status := range workflow.Statuses {
+		if status.Category == category {
+			return status.ID, true
+		}
+	}
+
+	return 0, false
+}
+
+func allTasks(ctx context.Context, dbService client.DatabaseService) ([]dto.Task, error) {
+	req := dto.GetTasksRequest{
+		OrderBy: dto.OrderBy{
+			Field: dto.CreatedAt,
+		},
+	}
+
+	var tasks []dto.Task
+	err := forEachTasksPage(ctx, dbService, req, func(page []dto.Task) error {
+		tasks = append(tasks, page...)
+		return nil
+	})
+
+	return tasks, err
+}
+
+func parseTasksQuery(c *gin.Context) (dto.GetTasksRequest, error) {
+	var req dto.GetTasksRequest
+	req.Title = c.Query("title")
+
+	for value := range strings.SplitSeq(c.Query("statuses"), ",") {
+		if value == "" {
+			continue
+		}
+		status, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.TaskStatuses = append(req.Filters.TaskStatuses, dto.TaskStatus(status))
+	}
+
+	for value := range strings.SplitSeq(c.Query("priorities"), ",") {
+		if value == "" {
+			continue
+		}
+		priority, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.TaskPriorities = append(req.Filters.TaskPriorities, dto.TaskPriority(priority))
+	}
+
+	req.Filters.DueBefore = c.Query("due_before")
+	req.Filters.DueAfter = c.Query("due_after")
+	req.Filters.CreatedBefore = c.Query("created_before")
+	req.Filters.CreatedAfter = c.Query("created_after")
+	req.Filters.Timezone = c.Query("timezone")
+
+	if value := c.Query("overdue_only"); value != "" {
+		overdueOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.OverdueOnly = overdueOnly
+	}
+
+	if value := c.Query("has_due_date"); value != "" {
+		hasDueDate, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.HasDueDate = &hasDueDate
+	}
+
+	if value := c.Query("has_estimate"); value != "" {
+		hasEstimate, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.HasEstimate = &hasEstimate
+	}
+
+	if value := c.Query("max_estimate_minutes"); value != "" {
+		maxEstimate, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.MaxEstimateMinutes = uint32(maxEstimate)
+	}
+
+	for value := range strings.SplitSeq(c.Query("story_points"), ",") {
+		if value == "" {
+			continue
+		}
+		points, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.StoryPoints = append(req.Filters.StoryPoints, uint32(points))
+	}
+
+	if value := c.Query("shared_with_me"); value != "" {
+		sharedWithMe, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.SharedWithMe = sharedWithMe
+	}
+
+	if value := c.Query("assigned_to_me"); value != "" {
+		assignedToMe, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, err
+		}
+		req.Filters.AssignedToMe = assignedToMe
+	}
+	req.Filters.AssigneeID = c.Query("assignee")
+
+	if value := c.Query("sort_field"); value != "" {
+		field, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return req, err
+		}
+		req.OrderBy.Field = dto.SortField(field)
+	}
+
+	if value := c.Query("sort_direction"); value != "" {
+		direction, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return req, err
+		}
+		req.OrderBy.Direction = dto.SortDirection(direction)
+	}
+
+	// sort_keys=field:direction:nulls,... e.g. 1:0:0,3 sorts by due date, then title
+	for value := range strings.SplitSeq(c.Query("sort_keys"), ",") {
+		if value == "" {
+			continue
+		}
+
+		var key dto.OrderBy
+		for i, part := range strings.SplitN(value, ":", 3) {
+			n, err := strconv.ParseUint(part, 10, 8)
+			if err != nil {
+				return req, err
+			}
+
+			switch i {
+			case 0:
+				key.Field = dto.SortField(n)
+			case 1:
+				key.Direction = dto.SortDirection(n)
+			case 2:
+				key.Nulls = dto.NullsOrder(n)
+			}
+		}
+		req.SortKeys = append(req.SortKeys, key)
+	}
+
+	return req, nil
+}
+
 func RenderLanding() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.HTML(http.StatusOK, "landing.html", nil)
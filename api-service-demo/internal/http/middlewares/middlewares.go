@@ -5,67 +5,199 @@ import (
 	"strings"
 	"time"
 
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+	client "github.com/braunkc/todo-app/api-service-demo/internal/grpc"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ratelimit"
 	"github.com/braunkc/todo-app/api-service-demo/internal/token"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware(jwtService token.JWTService) gin.HandlerFunc {
+// cookies browsers keep the session's tokens in
+const (
+	AccessCookie  = "Authorization"
+	RefreshCookie = "Refresh"
+)
+
+type sessionClaims struct {
+	userID      string
+	workspaceID string
+	sessionID   string
+}
+
+// AuthMiddleware lets requests with an access token of a session that wasn't revoked through.
+// Browsers keep the refresh token in a cookie, their session is refreshed on the way
+// once the access token expires.
+func AuthMiddleware(jwtService token.JWTService, dbService client.DatabaseService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenString, err := c.Cookie("Authorization")
-		if err != nil {
-			authHeader := c.GetHeader("Authorization")
-			if authHeader == "" {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Требуется аутентификация"})
-				c.Abort()
-				return
+		claims, message := accessClaims(c, jwtService)
+		if message == "" {
+			ctx := client.WithUserID(c.Request.Context(), claims.userID)
+			if _, err := dbService.GetSession(ctx, claims.sessionID); err != nil {
+				message = "Сессия завершена"
 			}
+		}
 
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Неверный формат заголовка Authorization"})
+		if message != "" {
+			var ok bool
+			if claims, ok = refreshFromCookie(c, jwtService, dbService); !ok {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": message})
 				c.Abort()
 				return
 			}
-
-			tokenString = parts[1]
 		}
 
-		token, err := jwtService.Parse(tokenString)
-		if err != nil || !token.Valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Неверный или просроченный токен"})
-			c.Abort()
-			return
-		}
+		c.Set("user_id", claims.userID)
+		c.Set("workspace_id", claims.workspaceID)
+		c.Set("session_id", claims.sessionID)
+		c.Request = c.Request.WithContext(client.WithWorkspaceID(c.Request.Context(), claims.workspaceID))
+		c.Next()
+	}
+}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Неверный формат токена"})
-			c.Abort()
-			return
+// accessClaims returns the claims of the request's access token,
+// or why it can't be used if message isn't empty.
+func accessClaims(c *gin.Context, jwtService token.JWTService) (claims *sessionClaims, message string) {
+	tokenString, err := c.Cookie(AccessCookie)
+	if err != nil {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			return nil, "Требуется аутентификация"
 		}
 
-		exp, ok := claims["exp"].(float64)
-		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Отсутствует срок действия токена"})
-			c.Abort()
-			return
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			return nil, "Неверный формат заголовка Authorization"
 		}
 
-		if int64(exp) < time.Now().Unix() {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Токен просрочен"})
-			c.Abort()
-			return
-		}
+		tokenString = parts[1]
+	}
+
+	token, err := jwtService.Parse(tokenString)
+	if err != nil || !token.Valid {
+		return nil, "Неверный или просроченный токен"
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, "Неверный формат токена"
+	}
+
+	exp, ok := mapClaims["exp"].(float64)
+	if !ok {
+		return nil, "Отсутствует срок действия токена"
+	}
+
+	if int64(exp) < time.Now().Unix() {
+		return nil, "Токен просрочен"
+	}
+
+	userID, ok := mapClaims["user_id"].(string)
+	if !ok || userID == "" {
+		return nil, "Неверный идентификатор пользователя в токене"
+	}
+
+	// tokens issued before sessions can't be revoked, so they aren't accepted
+	sessionID, ok := mapClaims["session_id"].(string)
+	if !ok || sessionID == "" {
+		return nil, "Неверный идентификатор сессии в токене"
+	}
 
-		userID, ok := claims["user_id"].(string)
-		if !ok || userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Неверный идентификатор пользователя в токене"})
-			c.Abort()
+	// tokens of the personal workspace have none
+	workspaceID, _ := mapClaims["workspace_id"].(string)
+
+	return &sessionClaims{
+		userID:      userID,
+		workspaceID: workspaceID,
+		sessionID:   sessionID,
+	}, ""
+}
+
+// refreshFromCookie refreshes the session of the Refresh cookie, if there's one, and sets the new tokens.
+func refreshFromCookie(c *gin.Context, jwtService token.JWTService, dbService client.DatabaseService) (*sessionClaims, bool) {
+	refreshToken, err := c.Cookie(RefreshCookie)
+	if err != nil || refreshToken == "" {
+		return nil, false
+	}
+
+	resp, err := dbService.RefreshSession(c.Request.Context(), &dto.RefreshSessionRequest{
+		RefreshToken: refreshToken,
+		Device:       c.Request.UserAgent(),
+		IP:           c.ClientIP(),
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	if _, err := IssueTokens(c, jwtService, resp); err != nil {
+		return nil, false
+	}
+
+	return &sessionClaims{
+		userID:      resp.Session.UserID,
+		workspaceID: resp.Session.WorkspaceID,
+		sessionID:   resp.Session.ID,
+	}, true
+}
+
+// IssueTokens issues an access token of the session and sets it and the session's
+// refresh token as cookies, which are kept until the session expires.
+func IssueTokens(c *gin.Context, jwtService token.JWTService, session *dto.SessionResponse) (*dto.TokenResponse, error) {
+	accessToken, err := jwtService.Generate(session.Session.UserID, session.Session.WorkspaceID, session.Session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	SetAccessCookie(c, accessToken)
+	c.SetCookie(RefreshCookie, session.RefreshToken,
+		int(time.Until(time.Unix(session.Session.ExpiresAt, 0)).Seconds()), "/", "", false, true)
+
+	return &dto.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: session.RefreshToken,
+		ExpiresIn:    int64(token.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func SetAccessCookie(c *gin.Context, accessToken string) {
+	c.SetCookie(AccessCookie, accessToken, int(token.AccessTokenTTL.Seconds()), "/", "", false, true)
+}
+
+// ClearSessionCookies makes the browser forget the session's tokens.
+func ClearSessionCookies(c *gin.Context) {
+	c.SetCookie(AccessCookie, "", -1, "/", "", false, true)
+	c.SetCookie(RefreshCookie, "", -1, "/", "", false, true)
+}
+
+// KeyFunc returns whose bucket a request takes a token from.
+type KeyFunc func(c *gin.Context) string
+
+// ByIP keys requests by the client's address.
+func ByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// ByUserID keys requests by the authenticated user, by address before AuthMiddleware ran.
+func ByUserID(c *gin.Context) string {
+	if userID := c.GetString("user_id"); userID != "" {
+		return "user:" + userID
+	}
+
+	return ByIP(c)
+}
+
+// RateLimit lets requests through while the key's bucket of the policy has tokens,
+// then answers 429 with Retry-After. Requests go through if the store fails,
+// an outage of a shared store shouldn't take the API down.
+func RateLimit(store ratelimit.Store, policy ratelimit.Policy, key KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, wait, err := store.Take(c.Request.Context(), key(c), policy)
+		if err != nil || allowed {
+			c.Next()
 			return
 		}
 
-		c.Set("user_id", userID)
-		c.Next()
+		c.Header("Retry-After", ratelimit.RetryAfter(wait))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many requests"})
 	}
 }
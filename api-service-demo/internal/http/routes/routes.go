@@ -1,43 +1,163 @@
 package routes
 
 import (
+	"time"
+
 	client "github.com/braunkc/todo-app/api-service-demo/internal/grpc"
 	"github.com/braunkc/todo-app/api-service-demo/internal/http/handlers"
 	"github.com/braunkc/todo-app/api-service-demo/internal/http/middlewares"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ratelimit"
 	"github.com/braunkc/todo-app/api-service-demo/internal/token"
 	"github.com/gin-gonic/gin"
 )
 
-func Setup(r *gin.Engine, jwtService token.JWTService, dbService client.DatabaseService) {
+var (
+	// loginPolicy lets an address try 5 logins at once and one more every 12 seconds,
+	// failures on an account are slowed down by LoginLockout on top.
+	loginPolicy    = ratelimit.Policy{Name: "login", Burst: 5, Refill: 12 * time.Second}
+	registerPolicy = ratelimit.Policy{Name: "register", Burst: 3, Refill: 10 * time.Minute}
+	// apiPolicy lets a user make 10 requests a second with bursts of 60.
+	apiPolicy = ratelimit.Policy{Name: "api", Burst: 60, Refill: 100 * time.Millisecond}
+	// importPolicy limits imports, which create many tasks at once, per user.
+	importPolicy = ratelimit.Policy{Name: "import", Burst: 5, Refill: time.Minute}
+	// feedPolicy limits calendar apps polling a feed per address.
+	feedPolicy = ratelimit.Policy{Name: "feed", Burst: 30, Refill: 2 * time.Second}
+	// refreshPolicy limits guessing refresh tokens per address.
+	refreshPolicy = ratelimit.Policy{Name: "refresh", Burst: 10, Refill: 6 * time.Second}
+)
+
+// LoginLockout delays logins after 3 failures on an account, by 1s doubling each time,
+// and locks it out for 15 minutes after 10.
+var LoginLockout = ratelimit.LockoutPolicy{
+	FreeAttempts: 3,
+	Delay:        time.Second,
+	LockoutAfter: 10,
+	LockoutFor:   15 * time.Minute,
+	ResetAfter:   15 * time.Minute,
+}
+
+func Setup(r *gin.Engine, jwtService token.JWTService, dbService client.DatabaseService,
+	limiter ratelimit.Store, lockout ratelimit.Lockout) {
+	auth := middlewares.AuthMiddleware(jwtService, dbService)
+	userLimit := middlewares.RateLimit(limiter, apiPolicy, middlewares.ByUserID)
+	importLimit := middlewares.RateLimit(limiter, importPolicy, middlewares.ByUserID)
+
 	api := r.Group("/api")
 	{
 		v1 := api.Group("/v1")
 		{
-			v1.POST("register", handlers.Register(jwtService, dbService))
-			v1.POST("login", handlers.Login(jwtService, dbService))
-			v1.POST("logout", handlers.Logout())
+			v1.POST("register", middlewares.RateLimit(limiter, registerPolicy, middlewares.ByIP),
+				handlers.Register(jwtService, dbService))
+			v1.POST("login", middlewares.RateLimit(limiter, loginPolicy, middlewares.ByIP),
+				handlers.Login(jwtService, dbService, lockout))
+			v1.POST("logout", auth, handlers.Logout(dbService))
+			v1.POST("token/refresh", middlewares.RateLimit(limiter, refreshPolicy, middlewares.ByIP),
+				handlers.RefreshToken(jwtService, dbService))
+
+			sessions := v1.Group("/sessions")
+			sessions.Use(auth, userLimit)
+			{
+				sessions.GET("/", handlers.ListSessions(dbService))
+				// log out everywhere
+				sessions.DELETE("/", handlers.RevokeAllSessions(dbService))
+				sessions.DELETE("/:id", handlers.RevokeSession(dbService))
+			}
 
 			user := v1.Group("/user")
-			user.Use(middlewares.AuthMiddleware(jwtService))
+			user.Use(auth, userLimit)
 			{
 				user.DELETE("/", handlers.DeleteUser(jwtService, dbService))
+				user.GET("/settings", handlers.GetUserSettings(dbService))
+				user.PUT("/settings", handlers.UpdateUserSettings(dbService))
 			}
 
 			task := v1.Group("/task")
-			task.Use(middlewares.AuthMiddleware(jwtService))
+			task.Use(auth, userLimit)
 			{
 				task.POST("/", handlers.CreateTask(dbService))
+				task.POST("/quick", handlers.QuickAddTask(dbService))
 				task.PATCH("/", handlers.UpdateTask(dbService))
 				task.DELETE("/", handlers.DeleteTask(dbService))
+				task.PATCH("/bulk", handlers.BulkUpdateTasks(dbService))
+				task.GET("/:id/collaborators", handlers.ListCollaborators(dbService))
+				task.POST("/:id/collaborators", handlers.ShareTask(dbService))
+				task.DELETE("/:id/collaborators/:username", handlers.UnshareTask(dbService))
+				task.PUT("/:id/assignee", handlers.AssignTask(dbService))
+				task.POST("/:id/move", handlers.MoveTask(dbService))
 			}
 
 			// return tasks in json
-			v1.POST("/tasks", middlewares.AuthMiddleware(jwtService), handlers.GetTasks(dbService))
+			v1.POST("/tasks", auth, userLimit, handlers.GetTasks(dbService))
+
+			tasks := v1.Group("/tasks")
+			tasks.Use(auth, userLimit)
+			{
+				tasks.GET("/export.csv", handlers.ExportTasksCSV(dbService))
+				tasks.POST("/import", importLimit, handlers.ImportTasks(dbService))
+				tasks.POST("/import/:source", importLimit, handlers.ImportFromSource(dbService))
+				tasks.GET("/export.ics", handlers.ExportTasksICS(dbService))
+				tasks.GET("/stats", handlers.GetTaskStats(dbService))
+				tasks.POST("/undo", handlers.Undo(dbService))
+				tasks.POST("/redo", handlers.Redo(dbService))
+				tasks.GET("/todo.txt", handlers.ExportTodoTxt(dbService))
+				tasks.POST("/todo.txt/sync", importLimit, handlers.SyncTodoTxt(dbService))
+			}
+
+			templates := v1.Group("/templates")
+			templates.Use(auth, userLimit)
+			{
+				templates.GET("/", handlers.ListTaskTemplates(dbService))
+				templates.POST("/", handlers.CreateTaskTemplate(dbService))
+				templates.GET("/:id", handlers.GetTaskTemplate(dbService))
+				templates.PUT("/:id", handlers.UpdateTaskTemplate(dbService))
+				templates.DELETE("/:id", handlers.DeleteTaskTemplate(dbService))
+				templates.POST("/:id/instantiate", handlers.InstantiateTemplate(dbService))
+			}
+
+			workflow := v1.Group("/workflow")
+			workflow.Use(auth, userLimit)
+			{
+				workflow.GET("/", handlers.GetWorkflow(dbService))
+				workflow.PUT("/", handlers.UpdateWorkflow(dbService))
+			}
+
+			workspaces := v1.Group("/workspaces")
+			workspaces.Use(auth, userLimit)
+			{
+				workspaces.GET("/", handlers.ListWorkspaces(dbService))
+				workspaces.POST("/", handlers.CreateWorkspace(dbService))
+				workspaces.GET("/invitations", handlers.ListWorkspaceInvitations(dbService))
+				workspaces.POST("/invitations/:id/accept", handlers.RespondToWorkspaceInvitation(dbService, true))
+				workspaces.POST("/invitations/:id/decline", handlers.RespondToWorkspaceInvitation(dbService, false))
+				workspaces.POST("/:id/switch", handlers.SwitchWorkspace(jwtService, dbService))
+				workspaces.POST("/:id/invitations", handlers.InviteToWorkspace(dbService))
+				workspaces.POST("/:id/leave", handlers.LeaveWorkspace(jwtService, dbService))
+			}
+
+			timeTracking := v1.Group("/time")
+			timeTracking.Use(auth, userLimit)
+			{
+				timeTracking.POST("/start", handlers.StartTimer(dbService))
+				timeTracking.POST("/stop", handlers.StopTimer(dbService))
+				timeTracking.POST("/entries", handlers.AddTimeEntry(dbService))
+				timeTracking.GET("/entries", handlers.ListTimeEntries(dbService))
+				timeTracking.GET("/report", handlers.GetTimeReport(dbService))
+			}
+
+			calendar := v1.Group("/calendar")
+			calendar.Use(auth, userLimit)
+			{
+				calendar.POST("/feed", handlers.CreateCalendarFeed(dbService))
+				calendar.DELETE("/feed", handlers.RevokeCalendarFeed(dbService))
+			}
 		}
 	}
 
 	// landing
 	r.GET("/", handlers.RenderLanding())
 	r.GET("auth", handlers.RenderAuth())
-	r.GET("/tasks", middlewares.AuthMiddleware(jwtService), handlers.RenderTasks())
+	r.GET("/tasks", auth, handlers.RenderTasks())
+
+	// secret calendar feed, authenticated by the token in the URL
+	r.GET("/calendar/:token", middlewares.RateLimit(limiter, feedPolicy, middlewares.ByIP), handlers.CalendarFeed(dbService))
 }
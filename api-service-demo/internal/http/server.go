@@ -3,18 +3,25 @@ package server
 import (
 	client "github.com/braunkc/todo-app/api-service-demo/internal/grpc"
 	"github.com/braunkc/todo-app/api-service-demo/internal/http/routes"
+	"github.com/braunkc/todo-app/api-service-demo/internal/ratelimit"
 	"github.com/braunkc/todo-app/api-service-demo/internal/token"
 	"github.com/gin-gonic/gin"
 )
 
-func New(jwtService token.JWTService, dbService client.DatabaseService) *gin.Engine {
+// New serves the API, trustedProxies are the addresses allowed to set the client address
+// rate limits go by in X-Forwarded-For.
+func New(jwtService token.JWTService, dbService client.DatabaseService,
+	limiter ratelimit.Store, lockout ratelimit.Lockout, trustedProxies []string) (*gin.Engine, error) {
 	r := gin.Default()
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
 
 	r.LoadHTMLGlob("./web/templates/*")
 	r.Static("/css", "./web/static/css")
 	r.Static("/js", "./web/static/js")
 
-	routes.Setup(r, jwtService, dbService)
+	routes.Setup(r, jwtService, dbService, limiter, lockout)
 
-	return r
+	return r, nil
 }
@@ -0,0 +1,164 @@
+package ical
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+type Component uint8
+
+const (
+	VTodo Component = 1 << iota
+	VEvent
+)
+
+const (
+	dateTimeFormat = "20060102T150405Z"
+	dateFormat     = "20060102"
+	maxLineLength  = 75
+)
+
+// Write renders tasks as an iCalendar (RFC 5545) document. Every task becomes a VTODO
+// when components include VTodo, and tasks with a due date also become a VEVENT
+// at the due time when components include VEvent. All-day tasks use dates
+// instead of times, so calendars show them on their day in any timezone.
+func Write(w io.Writer, name string, tasks []dto.Task, components Component) error {
+	var b strings.Builder
+	now := time.Now().UTC().Format(dateTimeFormat)
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//todo-app//tasks//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "X-WR-CALNAME:"+escape(name))
+
+	for _, task := range tasks {
+		if components&VTodo != 0 {
+			writeLine(&b, "BEGIN:VTODO")
+			writeLine(&b, "UID:"+task.ID+"@todo-app")
+			writeLine(&b, "DTSTAMP:"+now)
+			writeCommon(&b, task)
+			if task.DueDate != 0 && task.AllDay {
+				writeLine(&b, "DUE;VALUE=DATE:"+formatDate(task.DueDate, 0))
+			} else if task.DueDate != 0 {
+				writeLine(&b, "DUE:"+formatTime(task.DueDate))
+			}
+			writeLine(&b, "STATUS:"+todoStatus(task.StatusCategory))
+			writeLine(&b, "END:VTODO")
+		}
+
+		if components&VEvent != 0 && task.DueDate != 0 {
+			writeLine(&b, "BEGIN:VEVENT")
+			writeLine(&b, "UID:"+task.ID+"-event@todo-app")
+			writeLine(&b, "DTSTAMP:"+now)
+			writeCommon(&b, task)
+			if task.AllDay {
+				writeLine(&b, "DTSTART;VALUE=DATE:"+formatDate(task.DueDate, 0))
+				writeLine(&b, "DTEND;VALUE=DATE:"+formatDate(task.DueDate, 1))
+			} else {
+				writeLine(&b, "DTSTART:"+formatTime(task.DueDate))
+				writeLine(&b, "DTEND:"+formatTime(task.DueDate))
+			}
+			writeLine(&b, "STATUS:"+eventStatus(task.StatusCategory))
+			writeLine(&b, "END:VEVENT")
+		}
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeCommon(b *strings.Builder, task dto.Task) {
+	writeLine(b, "SUMMARY:"+escape(task.Title))
+	if task.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escape(task.Description))
+	}
+	if task.CreatedAt != 0 {
+		writeLine(b, "CREATED:"+formatTime(task.CreatedAt))
+	}
+	writeLine(b, "PRIORITY:"+strconv.Itoa(priority(task.Priority)))
+}
+
+// priority maps to the RFC 5545 scale where 1 is the highest and 9 the lowest.
+func priority(p dto.TaskPriority) int {
+	switch p {
+	case dto.TaskPriorityHigh:
+		return 1
+	case dto.TaskPriorityMedium:
+		return 5
+	case dto.TaskPriorityLow:
+		return 9
+	default:
+		return 0
+	}
+}
+
+func todoStatus(c dto.StatusCategory) string {
+	switch c {
+	case dto.StatusCategoryActive:
+		return "IN-PROCESS"
+	case dto.StatusCategoryDone:
+		return "COMPLETED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+func eventStatus(c dto.StatusCategory) string {
+	if c == dto.StatusCategoryDone {
+		return "CONFIRMED"
+	}
+
+	return "TENTATIVE"
+}
+
+// formatDate formats the date of an all-day due date moved by days.
+func formatDate(unix int64, days int) string {
+	return time.Unix(unix, 0).UTC().AddDate(0, 0, days).Format(dateFormat)
+}
+
+func formatTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(dateTimeFormat)
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine folds content lines longer than 75 octets as required by RFC 5545,
+// without splitting multi-byte characters.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space which counts towards the limit
+		limit = maxLineLength - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -0,0 +1,110 @@
+package importers
+
+import (
+	"io"
+	"strings"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+const (
+	SourceTrello      = "trello"
+	SourceTodoist     = "todoist"
+	SourceTaskwarrior = "taskwarrior"
+)
+
+// Importer converts an export of another tool into import rows.
+// Items that can't be represented as tasks are reported in Result.Skipped,
+// items imported without a field that couldn't be read in Result.Notes.
+type Importer interface {
+	Import(r io.Reader) (*Result, error)
+}
+
+type Result struct {
+	Rows    []dto.ImportTaskRow
+	Skipped []dto.SkippedItem
+	Notes   []dto.SkippedItem
+}
+
+func (r *Result) skip(ref, title, reason string) {
+	r.Skipped = append(r.Skipped, dto.SkippedItem{
+		Ref:    ref,
+		Title:  title,
+		Reason: reason,
+	})
+}
+
+func (r *Result) note(ref, title, reason string) {
+	r.Notes = append(r.Notes, dto.SkippedItem{
+		Ref:    ref,
+		Title:  title,
+		Reason: reason,
+	})
+}
+
+// Get returns the importer for the source, false if the source is not supported.
+func Get(source string) (Importer, bool) {
+	switch strings.ToLower(source) {
+	case SourceTrello:
+		return NewTrelloImporter(), true
+	case SourceTodoist:
+		return NewTodoistImporter(), true
+	case SourceTaskwarrior:
+		return NewTaskwarriorImporter(), true
+	}
+
+	return nil, false
+}
+
+// statusFromList guesses the status from the name of a list, column or section,
+// "" (todo) if the name doesn't look like a workflow step.
+func statusFromList(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.Contains(name, "done"), strings.Contains(name, "complete"), strings.Contains(name, "finished"):
+		return "done"
+	case strings.Contains(name, "doing"), strings.Contains(name, "progress"), strings.Contains(name, "wip"):
+		return "in_progress"
+	}
+
+	return ""
+}
+
+// priorityFromLabels picks the priority from labels like "high" or "urgent"
+// and returns the remaining labels.
+func priorityFromLabels(labels []string) (string, []string) {
+	priority := ""
+	rest := make([]string, 0, len(labels))
+	for _, label := range labels {
+		switch strings.ToLower(strings.TrimSpace(label)) {
+		case "high", "urgent", "critical", "high priority":
+			priority = "high"
+		case "medium", "normal", "medium priority":
+			if priority == "" {
+				priority = "medium"
+			}
+		case "low", "low priority":
+			if priority == "" {
+				priority = "low"
+			}
+		default:
+			rest = append(rest, label)
+		}
+	}
+
+	return priority, rest
+}
+
+// tagsFromLabels turns labels into task tags, which are one lower case word:
+// spaces become dashes and commas are dropped.
+func tagsFromLabels(labels []string) []string {
+	tags := make([]string, 0, len(labels))
+	for _, label := range labels {
+		words := strings.Fields(strings.ToLower(strings.ReplaceAll(label, ",", " ")))
+		if len(words) > 0 {
+			tags = append(tags, strings.Join(words, "-"))
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,111 @@
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+// taskwarriorTimeLayout is the ISO 8601 basic format used by "task export".
+const taskwarriorTimeLayout = "20060102T150405Z"
+
+type taskwarriorTask struct {
+	UUID        string                  `json:"uuid"`
+	Description string                  `json:"description"`
+	Status      string                  `json:"status"`
+	Project     string                  `json:"project"`
+	Priority    string                  `json:"priority"`
+	Due         string                  `json:"due"`
+	Start       string                  `json:"start"`
+	Tags        []string                `json:"tags"`
+	Annotations []taskwarriorAnnotation `json:"annotations"`
+}
+
+type taskwarriorAnnotation struct {
+	Description string `json:"description"`
+}
+
+type taskwarriorImporter struct{}
+
+// NewTaskwarriorImporter returns an importer for the JSON array from "task export".
+// Started tasks are in progress, the project and tags are kept and
+// annotations are added to the description. Deleted tasks and recurrence templates are skipped.
+func NewTaskwarriorImporter() Importer {
+	return &taskwarriorImporter{}
+}
+
+func (i *taskwarriorImporter) Import(r io.Reader) (*Result, error) {
+	var tasks []taskwarriorTask
+	if err := json.NewDecoder(r).Decode(&tasks); err != nil {
+		return nil, fmt.Errorf("invalid taskwarrior export: %w", err)
+	}
+
+	result := &Result{}
+	for n, task := range tasks {
+		var status string
+		switch task.Status {
+		case "pending", "waiting":
+			if task.Start != "" {
+				status = "in_progress"
+			}
+		case "completed":
+			status = "done"
+		case "deleted":
+			result.skip(task.UUID, task.Description, "task is deleted")
+			continue
+		case "recurring":
+			result.skip(task.UUID, task.Description, "recurring task template")
+			continue
+		default:
+			result.skip(task.UUID, task.Description, fmt.Sprintf("unsupported status %q", task.Status))
+			continue
+		}
+
+		if strings.TrimSpace(task.Description) == "" {
+			result.skip(task.UUID, task.Description, "task has no description")
+			continue
+		}
+
+		var dueDate string
+		if task.Due != "" {
+			due, err := time.Parse(taskwarriorTimeLayout, task.Due)
+			if err != nil {
+				result.note(task.UUID, task.Description, fmt.Sprintf("imported without the invalid due date %q", task.Due))
+			} else {
+				dueDate = due.Format(time.RFC3339)
+			}
+		}
+
+		priority, tags := priorityFromLabels(task.Tags)
+		switch task.Priority {
+		case "H":
+			priority = "high"
+		case "M":
+			priority = "medium"
+		case "L":
+			priority = "low"
+		}
+
+		annotations := make([]string, 0, len(task.Annotations))
+		for _, annotation := range task.Annotations {
+			annotations = append(annotations, annotation.Description)
+		}
+
+		result.Rows = append(result.Rows, dto.ImportTaskRow{
+			Line:        int64(n + 1),
+			Title:       task.Description,
+			Description: strings.TrimSpace(strings.Join(annotations, "\n")),
+			Status:      status,
+			Priority:    priority,
+			DueDate:     dueDate,
+			Project:     task.Project,
+			Tags:        tagsFromLabels(tags),
+		})
+	}
+
+	return result, nil
+}
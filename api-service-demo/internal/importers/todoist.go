@@ -0,0 +1,188 @@
+package importers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+var ErrMissingTodoistColumns = errors.New("TYPE and CONTENT columns are required")
+
+const (
+	todoistTypeTask    = "task"
+	todoistTypeSection = "section"
+	todoistTypeNote    = "note"
+)
+
+var todoistDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04"}
+
+type todoistImporter struct{}
+
+// NewTodoistImporter returns an importer for Todoist CSV templates.
+// Sections are the projects of the tasks below them, notes are appended
+// to the description of the task above and @labels in the content become tags.
+// Recurring and natural language dates ("every monday") are not supported.
+func NewTodoistImporter() Importer {
+	return &todoistImporter{}
+}
+
+func (i *todoistImporter) Import(r io.Reader) (*Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	head, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(head))
+	for i, name := range head {
+		columns[strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+
+	if _, ok := columns["TYPE"]; !ok {
+		return nil, ErrMissingTodoistColumns
+	}
+	if _, ok := columns["CONTENT"]; !ok {
+		return nil, ErrMissingTodoistColumns
+	}
+
+	result := &Result{}
+	section := ""
+	lastTask := -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		ref := strconv.Itoa(line)
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		content := get("CONTENT")
+		switch strings.ToLower(get("TYPE")) {
+		case "":
+			// empty separator rows
+			continue
+		case todoistTypeSection:
+			section = content
+			continue
+		case todoistTypeNote:
+			if lastTask < 0 {
+				result.skip(ref, content, "note without a task")
+				continue
+			}
+			row := &result.Rows[lastTask]
+			row.Description = strings.TrimSpace(row.Description + "\n" + content)
+			continue
+		case todoistTypeTask:
+		default:
+			result.skip(ref, content, fmt.Sprintf("unsupported type %q", get("TYPE")))
+			continue
+		}
+
+		title, labels := todoistLabels(content)
+		if title == "" {
+			result.skip(ref, content, "task has no content")
+			continue
+		}
+
+		dueDate, err := todoistDate(get("DATE"))
+		if err != nil {
+			result.note(ref, title, "imported without the due date: "+err.Error())
+		}
+
+		status := statusFromList(section)
+		project := section
+		if status != "" {
+			project = ""
+		}
+
+		labelPriority, labels := priorityFromLabels(labels)
+		priority := todoistPriority(get("PRIORITY"))
+		if priority == "" {
+			priority = labelPriority
+		}
+
+		result.Rows = append(result.Rows, dto.ImportTaskRow{
+			Line:        int64(line),
+			Title:       title,
+			Description: strings.TrimSpace(get("DESCRIPTION")),
+			Status:      status,
+			Priority:    priority,
+			DueDate:     dueDate,
+			Project:     project,
+			Tags:        tagsFromLabels(labels),
+		})
+		lastTask = len(result.Rows) - 1
+	}
+
+	return result, nil
+}
+
+// todoistLabels splits "@label" words off the content.
+func todoistLabels(content string) (string, []string) {
+	var labels []string
+	words := strings.Fields(content)
+	title := make([]string, 0, len(words))
+	for _, word := range words {
+		if len(word) > 1 && word[0] == '@' {
+			labels = append(labels, word[1:])
+			continue
+		}
+		title = append(title, word)
+	}
+
+	return strings.Join(title, " "), labels
+}
+
+// todoistPriority maps p1 (1) to high, p2 to medium and p3 to low.
+// p4 is Todoist's default, so it's left to the labels.
+func todoistPriority(priority string) string {
+	switch priority {
+	case "1":
+		return "high"
+	case "2":
+		return "medium"
+	case "3":
+		return "low"
+	}
+
+	return ""
+}
+
+// todoistDate converts the date of a task for the import, dates without
+// a time stay YYYY-MM-DD so that the task is all-day.
+func todoistDate(date string) (string, error) {
+	if date == "" {
+		return "", nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, date); err == nil {
+		return t.Format(time.DateOnly), nil
+	}
+
+	for _, layout := range todoistDateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t.UTC().Format(time.RFC3339), nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported date %q", date)
+}
@@ -0,0 +1,112 @@
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+type trelloBoard struct {
+	Lists []trelloList `json:"lists"`
+	Cards []trelloCard `json:"cards"`
+}
+
+type trelloList struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+type trelloCard struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Desc        string        `json:"desc"`
+	IDList      string        `json:"idList"`
+	Closed      bool          `json:"closed"`
+	Due         *time.Time    `json:"due"`
+	DueComplete bool          `json:"dueComplete"`
+	Labels      []trelloLabel `json:"labels"`
+}
+
+type trelloLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloImporter struct{}
+
+// NewTrelloImporter returns an importer for the board JSON from Trello's "Export as JSON".
+// Cards become tasks, the list is mapped to the status when its name looks like
+// a workflow step (e.g. "Doing", "Done") and becomes the project otherwise. Labels become tags.
+func NewTrelloImporter() Importer {
+	return &trelloImporter{}
+}
+
+func (i *trelloImporter) Import(r io.Reader) (*Result, error) {
+	var board trelloBoard
+	if err := json.NewDecoder(r).Decode(&board); err != nil {
+		return nil, fmt.Errorf("invalid trello board: %w", err)
+	}
+
+	lists := make(map[string]trelloList, len(board.Lists))
+	for _, list := range board.Lists {
+		lists[list.ID] = list
+	}
+
+	result := &Result{}
+	for n, card := range board.Cards {
+		list, ok := lists[card.IDList]
+		switch {
+		case card.Closed:
+			result.skip(card.ID, card.Name, "card is archived")
+			continue
+		case ok && list.Closed:
+			result.skip(card.ID, card.Name, fmt.Sprintf("list %q is archived", list.Name))
+			continue
+		case strings.TrimSpace(card.Name) == "":
+			result.skip(card.ID, card.Name, "card has no name")
+			continue
+		}
+
+		labels := make([]string, 0, len(card.Labels))
+		for _, label := range card.Labels {
+			// unnamed labels are identified by color only
+			if label.Name != "" {
+				labels = append(labels, label.Name)
+			} else if label.Color != "" {
+				labels = append(labels, label.Color)
+			}
+		}
+		priority, labels := priorityFromLabels(labels)
+
+		status := statusFromList(list.Name)
+		project := list.Name
+		if status != "" {
+			project = ""
+		}
+		if card.DueComplete {
+			status = "done"
+		}
+
+		row := dto.ImportTaskRow{
+			Line:        int64(n + 1),
+			Title:       card.Name,
+			Description: strings.TrimSpace(card.Desc),
+			Status:      status,
+			Priority:    priority,
+			Project:     project,
+			Tags:        tagsFromLabels(labels),
+		}
+		if card.Due != nil {
+			row.DueDate = card.Due.UTC().Format(time.RFC3339)
+		}
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
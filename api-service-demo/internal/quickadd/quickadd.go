@@ -0,0 +1,490 @@
+package quickadd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+var priorities = map[string]dto.TaskPriority{
+	"!low":    dto.TaskPriorityLow,
+	"!medium": dto.TaskPriorityMedium,
+	"!med":    dto.TaskPriorityMedium,
+	"!high":   dto.TaskPriorityHigh,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// unit is a unit of "in 3 days" and "every 2 weeks", days is 0 for the units that aren't whole days.
+type unit struct {
+	name string
+	days int
+}
+
+// recurs reports whether tasks can repeat every unit.
+func (u unit) recurs() bool {
+	return u.days > 0 || u.name == "month" || u.name == "year"
+}
+
+var units = map[string]unit{
+	"minute": {"minute", 0}, "minutes": {"minute", 0}, "min": {"minute", 0}, "mins": {"minute", 0}, "m": {"minute", 0},
+	"hour": {"hour", 0}, "hours": {"hour", 0}, "h": {"hour", 0},
+	"day": {"day", 1}, "days": {"day", 1}, "d": {"day", 1},
+	"week": {"week", 7}, "weeks": {"week", 7}, "w": {"week", 7},
+	"month": {"month", 0}, "months": {"month", 0},
+	"year": {"year", 0}, "years": {"year", 0},
+}
+
+// repeats are the single-word recurrences and what they are short for.
+var repeats = map[string]string{
+	"daily":    "every day",
+	"weekly":   "every week",
+	"monthly":  "every month",
+	"yearly":   "every year",
+	"annually": "every year",
+}
+
+// connectors go before a date or a time and are dropped with it, like "on" in "on friday".
+var connectors = map[string]bool{"on": true, "by": true, "due": true, "at": true}
+
+var (
+	clockTime = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	countUnit = regexp.MustCompile(`^(\d{1,3})([a-z]+)$`)
+	dayNumber = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+)
+
+// Parse reads a task typed in one line, like "Pay rent tomorrow 9am !high #home".
+// Dates are relative to now and in its location, the user's:
+//
+//   - today, tomorrow, monday (the next one), next week (next monday), next month (its first day)
+//   - in 3 days, in 2 hours, in 30min, in a week
+//   - 2024-05-01, may 1, 1st may 2025; dates without a year that passed are next year's
+//   - 9am, 9:30 pm, 21:00, noon, alone they mean the next time the clock shows it
+//
+// "on", "by", "due" and "at" before a date or a time are dropped with it. A date without a
+// time makes an all-day task. !low, !medium and !high set the priority, #tag adds a tag and
+// +project sets the project. "every monday", "every 2 weeks" or "daily" is a recurrence: tasks
+// don't repeat, so it's reported as unparsed and only makes the task due at its next occurrence
+// if there is no date. The remaining words are the title.
+func Parse(text string, now time.Time) dto.ParsedTask {
+	p := parser{
+		words: strings.Fields(text),
+		now:   now,
+	}
+
+	var title []string
+	for i := 0; i < len(p.words); {
+		if n := p.match(i); n > 0 {
+			i += n
+			continue
+		}
+
+		title = append(title, p.words[i])
+		i++
+	}
+	p.parsed.Task.Title = strings.Join(title, " ")
+	p.finish()
+
+	return p.parsed
+}
+
+type parser struct {
+	words  []string
+	now    time.Time
+	parsed dto.ParsedTask
+
+	date    time.Time // midnight of the due date in now's location, zero if there is none
+	hasTime bool
+	hour    int
+	minute  int
+	// start is the first day of the recurrence, used if there is no date,
+	// and repeat returns the day it recurs on after a day
+	start  time.Time
+	repeat func(day time.Time) time.Time
+}
+
+// match parses the phrase starting at the i-th word and returns how many words it took, 0 if none.
+func (p *parser) match(i int) int {
+	word := p.word(i)
+
+	if priority, ok := priorities[word]; ok {
+		p.parsed.Task.Priority = priority
+		return 1
+	}
+
+	if tag, ok := strings.CutPrefix(word, "#"); ok && tag != "" {
+		p.parsed.Task.Tags = append(p.parsed.Task.Tags, tag)
+		return 1
+	}
+
+	if project, ok := strings.CutPrefix(strings.TrimRight(p.words[i], ",.;"), "+"); ok && project != "" {
+		p.parsed.Task.Project = project
+		return 1
+	}
+
+	if n := p.matchRecurrence(i); n > 0 {
+		p.parsed.Unparsed = append(p.parsed.Unparsed, strings.Join(p.words[i:i+n], " "))
+		return n
+	}
+
+	if connectors[word] {
+		if n := p.matchWhen(i + 1); n > 0 {
+			return n + 1
+		}
+		return 0
+	}
+
+	return p.matchWhen(i)
+}
+
+// matchWhen parses a date or a time.
+func (p *parser) matchWhen(i int) int {
+	if n := p.matchTime(i); n > 0 {
+		return n
+	}
+
+	return p.matchDate(i)
+}
+
+func (p *parser) matchDate(i int) int {
+	today := p.day(p.now)
+	word := p.word(i)
+
+	switch word {
+	case "today":
+		p.date = today
+		return 1
+	case "tomorrow":
+		p.date = today.AddDate(0, 0, 1)
+		return 1
+	case "next":
+		switch next := p.word(i + 1); next {
+		case "week":
+			p.date = nextWeekday(today, time.Monday, false)
+			return 2
+		case "month":
+			p.date = time.Date(today.Year(), today.Month()+1, 1, 0, 0, 0, 0, today.Location())
+			return 2
+		case "year":
+			p.date = time.Date(today.Year()+1, time.January, 1, 0, 0, 0, 0, today.Location())
+			return 2
+		default:
+			if weekday, ok := weekdays[next]; ok {
+				p.date = nextWeekday(today, weekday, false)
+				return 2
+			}
+		}
+		return 0
+	case "in":
+		return p.matchIn(i)
+	}
+
+	if weekday, ok := weekdays[word]; ok {
+		p.date = nextWeekday(today, weekday, false)
+		return 1
+	}
+
+	if date, err := time.ParseInLocation(time.DateOnly, word, p.now.Location()); err == nil {
+		p.date = date
+		return 1
+	}
+
+	// may 1 [2025], 1 may [2025]
+	month, monthOK := months[word]
+	day, dayOK := p.dayNumber(i + 1)
+	n := 2
+	if !monthOK || !dayOK {
+		day, dayOK = p.dayNumber(i)
+		month, monthOK = months[p.word(i+1)]
+	}
+	if !monthOK || !dayOK {
+		return 0
+	}
+
+	year := today.Year()
+	if y, err := strconv.Atoi(p.word(i + 2)); err == nil && len(p.word(i+2)) == 4 {
+		year = y
+		n++
+	}
+
+	date := time.Date(year, month, day, 0, 0, 0, 0, today.Location())
+	if date.Day() != day {
+		return 0
+	}
+
+	if n == 2 && date.Before(today) {
+		date = date.AddDate(1, 0, 0)
+	}
+	p.date = date
+
+	return n
+}
+
+// matchIn parses "in 3 days", "in 3d" and "in a week".
+func (p *parser) matchIn(i int) int {
+	count, u, n := p.countUnit(i + 1)
+	if n == 0 {
+		return 0
+	}
+
+	due := p.now
+	switch u.name {
+	case "minute":
+		due = due.Add(time.Duration(count) * time.Minute)
+	case "hour":
+		due = due.Add(time.Duration(count) * time.Hour)
+	case "month":
+		due = due.AddDate(0, count, 0)
+	case "year":
+		due = due.AddDate(count, 0, 0)
+	default:
+		due = due.AddDate(0, 0, count*u.days)
+	}
+
+	p.date = p.day(due)
+	if u.name == "minute" || u.name == "hour" {
+		p.hasTime, p.hour, p.minute = true, due.Hour(), due.Minute()
+	}
+
+	return n + 1
+}
+
+func (p *parser) matchTime(i int) int {
+	word := p.word(i)
+	if word == "noon" {
+		p.hasTime, p.hour, p.minute = true, 12, 0
+		return 1
+	}
+
+	m := clockTime.FindStringSubmatch(word)
+	n := 1
+	if m != nil && m[3] == "" {
+		// 9 am, 9:30 pm
+		if next := p.word(i + 1); next == "am" || next == "pm" {
+			m[3] = next
+			n++
+		}
+	}
+
+	// a bare number is never a time
+	if m == nil || (m[2] == "" && m[3] == "") {
+		return 0
+	}
+
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+	if minute > 59 {
+		return 0
+	}
+
+	switch m[3] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0
+		}
+		hour %= 12
+		if m[3] == "pm" {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return 0
+		}
+	}
+
+	p.hasTime, p.hour, p.minute = true, hour, minute
+	return n
+}
+
+// matchRecurrence parses "every ..." and the single-word recurrences.
+func (p *parser) matchRecurrence(i int) int {
+	word := p.word(i)
+	today := p.day(p.now)
+
+	if recurrence, ok := repeats[word]; ok {
+		p.parsed.Recurrence = recurrence
+		p.recur(today, units[strings.TrimPrefix(recurrence, "every ")], 1)
+		return 1
+	}
+
+	if word != "every" {
+		return 0
+	}
+
+	next := p.word(i + 1)
+	if weekday, ok := weekdays[next]; ok {
+		p.parsed.Recurrence = "every " + next
+		p.recur(nextWeekday(today, weekday, true), units["week"], 1)
+		return 2
+	}
+
+	if next == "weekday" {
+		p.parsed.Recurrence = "every weekday"
+		p.start, p.repeat = nextWorkday(today.AddDate(0, 0, -1)), nextWorkday
+		return 2
+	}
+
+	if u, ok := units[next]; ok && u.recurs() {
+		p.parsed.Recurrence = "every " + u.name
+		p.recur(today, u, 1)
+		return 2
+	}
+
+	count, u, n := p.countUnit(i + 1)
+	if n == 0 || count < 1 || !u.recurs() {
+		return 0
+	}
+
+	p.parsed.Recurrence = "every " + u.name
+	if count > 1 {
+		p.parsed.Recurrence = "every " + strconv.Itoa(count) + " " + u.name + "s"
+	}
+	p.recur(today, u, count)
+
+	return n + 1
+}
+
+// recur makes the recurrence start on start and repeat every count units.
+func (p *parser) recur(start time.Time, u unit, count int) {
+	p.start = start
+	p.repeat = func(day time.Time) time.Time {
+		switch u.name {
+		case "month":
+			return day.AddDate(0, count, 0)
+		case "year":
+			return day.AddDate(count, 0, 0)
+		default:
+			return day.AddDate(0, 0, count*u.days)
+		}
+	}
+}
+
+// countUnit parses "3 days", "3d" and "a day" starting at the i-th word.
+func (p *parser) countUnit(i int) (int, unit, int) {
+	word := p.word(i)
+	if m := countUnit.FindStringSubmatch(word); m != nil {
+		if u, ok := units[m[2]]; ok {
+			count, _ := strconv.Atoi(m[1])
+			return count, u, 1
+		}
+	}
+
+	count, err := strconv.Atoi(word)
+	if word == "a" || word == "an" {
+		count, err = 1, nil
+	}
+	if err != nil || count > 999 {
+		return 0, unit{}, 0
+	}
+
+	u, ok := units[p.word(i+1)]
+	if !ok {
+		return 0, unit{}, 0
+	}
+
+	return count, u, 2
+}
+
+// finish sets the due date from the date and the time that were found.
+func (p *parser) finish() {
+	date := p.date
+	if date.IsZero() && p.repeat != nil {
+		date = p.start
+		if p.hasTime && !p.clock(date).After(p.now) {
+			date = p.repeat(date)
+		}
+	}
+
+	if date.IsZero() && p.hasTime {
+		date = p.day(p.now)
+		if !p.clock(date).After(p.now) {
+			date = date.AddDate(0, 0, 1)
+		}
+	}
+
+	if date.IsZero() {
+		return
+	}
+
+	if p.hasTime {
+		p.parsed.Task.DueDate = p.clock(date).Unix()
+		return
+	}
+
+	p.parsed.Task.DueDate = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix()
+	p.parsed.Task.AllDay = true
+}
+
+func (p *parser) clock(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), p.hour, p.minute, 0, 0, date.Location())
+}
+
+func (p *parser) day(t time.Time) time.Time {
+	t = t.In(p.now.Location())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func (p *parser) dayNumber(i int) (int, bool) {
+	m := dayNumber.FindStringSubmatch(p.word(i))
+	if m == nil {
+		return 0, false
+	}
+
+	day, _ := strconv.Atoi(m[1])
+	return day, day >= 1 && day <= 31
+}
+
+// word returns the i-th word for matching, "" past the end.
+func (p *parser) word(i int) string {
+	if i >= len(p.words) {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimRight(p.words[i], ",.;"))
+}
+
+// nextWorkday returns the next monday to friday after day.
+func nextWorkday(day time.Time) time.Time {
+	day = day.AddDate(0, 0, 1)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return day
+}
+
+// nextWeekday returns the next date after today that falls on weekday, today too if it may.
+func nextWeekday(today time.Time, weekday time.Weekday, orToday bool) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 && !orToday {
+		days = 7
+	}
+
+	return today.AddDate(0, 0, days)
+}
@@ -0,0 +1,156 @@
+package quickadd
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+func TestParseDates(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	// a wednesday afternoon
+	now := time.Date(2026, time.October, 14, 15, 30, 0, 0, loc)
+
+	allDay := func(year int, month time.Month, day int) int64 {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+	}
+	at := func(year int, month time.Month, day, hour, minute int) int64 {
+		return time.Date(year, month, day, hour, minute, 0, 0, loc).Unix()
+	}
+
+	tests := []struct {
+		text       string
+		title      string
+		dueDate    int64
+		allDay     bool
+		recurrence string
+		unparsed   []string
+	}{
+		{text: "Pay rent", title: "Pay rent"},
+		{text: "Pay rent today", title: "Pay rent", dueDate: allDay(2026, time.October, 14), allDay: true},
+		{text: "Pay rent tomorrow", title: "Pay rent", dueDate: allDay(2026, time.October, 15), allDay: true},
+		{text: "Pay rent on friday", title: "Pay rent", dueDate: allDay(2026, time.October, 16), allDay: true},
+		{text: "Pay rent wednesday", title: "Pay rent", dueDate: allDay(2026, time.October, 21), allDay: true},
+		{text: "Pay rent next friday", title: "Pay rent", dueDate: allDay(2026, time.October, 16), allDay: true},
+		{text: "Pay rent next week", title: "Pay rent", dueDate: allDay(2026, time.October, 19), allDay: true},
+		{text: "Pay rent next month", title: "Pay rent", dueDate: allDay(2026, time.November, 1), allDay: true},
+		{text: "Pay rent next year", title: "Pay rent", dueDate: allDay(2027, time.January, 1), allDay: true},
+		{text: "Pay rent in 3 days", title: "Pay rent", dueDate: allDay(2026, time.October, 17), allDay: true},
+		{text: "Pay rent in 3d", title: "Pay rent", dueDate: allDay(2026, time.October, 17), allDay: true},
+		{text: "Pay rent in a week", title: "Pay rent", dueDate: allDay(2026, time.October, 21), allDay: true},
+		{text: "Pay rent in 2 months", title: "Pay rent", dueDate: allDay(2026, time.December, 14), allDay: true},
+		{text: "Pay rent in 2 hours", title: "Pay rent", dueDate: at(2026, time.October, 14, 17, 30)},
+		{text: "Pay rent in 45min", title: "Pay rent", dueDate: at(2026, time.October, 14, 16, 15)},
+		{text: "Pay rent 2026-12-01", title: "Pay rent", dueDate: allDay(2026, time.December, 1), allDay: true},
+		{text: "Pay rent by dec 1", title: "Pay rent", dueDate: allDay(2026, time.December, 1), allDay: true},
+		{text: "Pay rent 1st dec", title: "Pay rent", dueDate: allDay(2026, time.December, 1), allDay: true},
+		{text: "Pay rent may 1", title: "Pay rent", dueDate: allDay(2027, time.May, 1), allDay: true},
+		{text: "Pay rent 1st may 2028", title: "Pay rent", dueDate: allDay(2028, time.May, 1), allDay: true},
+		{text: "Pay rent feb 30", title: "Pay rent feb 30"},
+		{text: "Pay rent 9am", title: "Pay rent", dueDate: at(2026, time.October, 15, 9, 0)},
+		{text: "Pay rent at 9:30 pm", title: "Pay rent", dueDate: at(2026, time.October, 14, 21, 30)},
+		{text: "Pay rent 21:00", title: "Pay rent", dueDate: at(2026, time.October, 14, 21, 0)},
+		{text: "Pay rent noon", title: "Pay rent", dueDate: at(2026, time.October, 15, 12, 0)},
+		{text: "Pay rent tomorrow 9 am", title: "Pay rent", dueDate: at(2026, time.October, 15, 9, 0)},
+		{text: "Pay rent friday at 10am", title: "Pay rent", dueDate: at(2026, time.October, 16, 10, 0)},
+		{text: "Pay rent 13pm", title: "Pay rent 13pm"},
+		{text: "Call 5 people", title: "Call 5 people"},
+		{text: "Call them on time", title: "Call them on time"},
+		{
+			text: "Water plants every monday", title: "Water plants",
+			dueDate: allDay(2026, time.October, 19), allDay: true,
+			recurrence: "every monday", unparsed: []string{"every monday"},
+		},
+		{
+			text: "Water plants every wednesday", title: "Water plants",
+			dueDate: allDay(2026, time.October, 14), allDay: true,
+			recurrence: "every wednesday", unparsed: []string{"every wednesday"},
+		},
+		{
+			text: "Stand-up daily 9am", title: "Stand-up",
+			dueDate:    at(2026, time.October, 15, 9, 0),
+			recurrence: "every day", unparsed: []string{"daily"},
+		},
+		{
+			text: "Review every 2 weeks", title: "Review",
+			dueDate: allDay(2026, time.October, 14), allDay: true,
+			recurrence: "every 2 weeks", unparsed: []string{"every 2 weeks"},
+		},
+		{
+			text: "Review every weekday", title: "Review",
+			dueDate: allDay(2026, time.October, 14), allDay: true,
+			recurrence: "every weekday", unparsed: []string{"every weekday"},
+		},
+		{
+			text: "Review every month on dec 1", title: "Review",
+			dueDate: allDay(2026, time.December, 1), allDay: true,
+			recurrence: "every month", unparsed: []string{"every month"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			parsed := Parse(tt.text, now)
+
+			if parsed.Task.Title != tt.title {
+				t.Errorf("title = %q, want %q", parsed.Task.Title, tt.title)
+			}
+
+			if parsed.Task.DueDate != tt.dueDate || parsed.Task.AllDay != tt.allDay {
+				t.Errorf("due = %s (all day %v), want %s (all day %v)",
+					time.Unix(parsed.Task.DueDate, 0).In(loc), parsed.Task.AllDay,
+					time.Unix(tt.dueDate, 0).In(loc), tt.allDay)
+			}
+
+			if parsed.Recurrence != tt.recurrence {
+				t.Errorf("recurrence = %q, want %q", parsed.Recurrence, tt.recurrence)
+			}
+
+			if !slices.Equal(parsed.Unparsed, tt.unparsed) {
+				t.Errorf("unparsed = %q, want %q", parsed.Unparsed, tt.unparsed)
+			}
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	now := time.Date(2026, time.October, 14, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		text     string
+		title    string
+		priority dto.TaskPriority
+		tags     []string
+		project  string
+	}{
+		{text: "Pay rent !high #home +bills", title: "Pay rent", priority: dto.TaskPriorityHigh, tags: []string{"home"}, project: "bills"},
+		{text: "Pay rent !med", title: "Pay rent", priority: dto.TaskPriorityMedium},
+		{text: "Pay rent #home #money", title: "Pay rent", tags: []string{"home", "money"}},
+		{text: "Pay rent, +bills.", title: "Pay rent,", project: "bills"},
+		{text: "Pay rent + tip #", title: "Pay rent + tip #"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			task := Parse(tt.text, now).Task
+
+			if task.Title != tt.title {
+				t.Errorf("title = %q, want %q", task.Title, tt.title)
+			}
+
+			if task.Priority != tt.priority {
+				t.Errorf("priority = %v, want %v", task.Priority, tt.priority)
+			}
+
+			if !slices.Equal(task.Tags, tt.tags) {
+				t.Errorf("tags = %q, want %q", task.Tags, tt.tags)
+			}
+
+			if task.Project != tt.project {
+				t.Errorf("project = %q, want %q", task.Project, tt.project)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Policy is a token bucket: Burst requests go through at once and
+// the bucket gets one more every Refill, up to Burst.
+type Policy struct {
+	// Name keeps the buckets of different policies for the same key apart
+	Name   string
+	Burst  int
+	Refill time.Duration
+}
+
+// Store holds the buckets, a store shared by all instances of the service can replace the in-memory one.
+type Store interface {
+	// Take takes a token from the key's bucket of the policy. If the bucket is empty
+	// it returns false and how long until the next token.
+	Take(ctx context.Context, key string, policy Policy) (bool, time.Duration, error)
+}
+
+// sweepInterval is how often stores forget the state that expired.
+const sweepInterval = time.Minute
+
+// maxLockout caps how long a lockout policy can block an account, whatever it's configured to.
+const maxLockout = 24 * time.Hour
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time // when the bucket is full again and can be forgotten
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryStore returns a store that keeps the buckets of this instance in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *memoryStore) Take(ctx context.Context, key string, policy Policy) (bool, time.Duration, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) > sweepInterval {
+		for k, b := range s.buckets {
+			if now.After(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	k := policy.Name + ":" + key
+	b, ok := s.buckets[k]
+	if !ok {
+		b = &bucket{tokens: float64(policy.Burst)}
+		s.buckets[k] = b
+	} else {
+		refilled := float64(now.Sub(b.updated)) / float64(policy.Refill)
+		b.tokens = math.Min(float64(policy.Burst), b.tokens+refilled)
+	}
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	b.full = now.Add(time.Duration((float64(policy.Burst) - b.tokens) * float64(policy.Refill)))
+
+	if !allowed {
+		return false, time.Duration((1 - b.tokens) * float64(policy.Refill)), nil
+	}
+
+	return true, 0, nil
+}
+
+// LockoutPolicy slows down guessing an account's password: after FreeAttempts failures every
+// further one makes the account wait Delay, doubling each time, and after LockoutAfter
+// failures it's locked for LockoutFor, at most maxLockout. Failures are forgotten ResetAfter
+// after the last one.
+type LockoutPolicy struct {
+	FreeAttempts int
+	Delay        time.Duration
+	LockoutAfter int
+	LockoutFor   time.Duration
+	ResetAfter   time.Duration
+}
+
+// Lockout tracks failed logins by account, a store shared by all instances
+// of the service can replace the in-memory one.
+type Lockout interface {
+	// Check returns how long the account has to wait before the next login attempt, 0 if it may try now.
+	Check(ctx context.Context, account string) (time.Duration, error)
+	// Fail records a failed login and returns how long the account has to wait now.
+	Fail(ctx context.Context, account string) (time.Duration, error)
+	// Reset forgets the failures after a successful login.
+	Reset(ctx context.Context, account string) error
+}
+
+type failures struct {
+	count        int
+	last         time.Time
+	blockedUntil time.Time
+}
+
+type memoryLockout struct {
+	policy    LockoutPolicy
+	mu        sync.Mutex
+	accounts  map[string]*failures
+	lastSweep time.Time
+}
+
+// NewMemoryLockout returns a lockout that keeps the failures seen by this instance in memory.
+func NewMemoryLockout(policy LockoutPolicy) Lockout {
+	return &memoryLockout{
+		policy:    policy,
+		accounts:  make(map[string]*failures),
+		lastSweep: time.Now(),
+	}
+}
+
+func (l *memoryLockout) Check(ctx context.Context, account string) (time.Duration, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f := l.failures(account, now)
+	if f == nil || !now.Before(f.blockedUntil) {
+		return 0, nil
+	}
+
+	return f.blockedUntil.Sub(now), nil
+}
+
+func (l *memoryLockout) Fail(ctx context.Context, account string) (time.Duration, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f := l.failures(account, now)
+	if f == nil {
+		f = &failures{}
+		l.accounts[account] = f
+	}
+	f.count++
+	f.last = now
+
+	lockout := min(l.policy.LockoutFor, maxLockout)
+
+	var wait time.Duration
+	switch {
+	case f.count >= l.policy.LockoutAfter:
+		wait = lockout
+	case f.count > l.policy.FreeAttempts:
+		// doubles step by step rather than shifting by the failure count, which could overflow
+		wait = min(l.policy.Delay, lockout)
+		for i := f.count - l.policy.FreeAttempts - 1; i > 0 && wait < lockout; i-- {
+			wait = min(wait*2, lockout)
+		}
+	}
+	f.blockedUntil = now.Add(wait)
+
+	return wait, nil
+}
+
+func (l *memoryLockout) Reset(ctx context.Context, account string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.accounts, account)
+
+	return nil
+}
+
+// failures returns the account's failures that aren't forgotten yet at now, nil if there are none.
+func (l *memoryLockout) failures(account string, now time.Time) *failures {
+	if now.Sub(l.lastSweep) > sweepInterval {
+		for a, f := range l.accounts {
+			if l.expired(f, now) {
+				delete(l.accounts, a)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	f, ok := l.accounts[account]
+	if !ok {
+		return nil
+	}
+
+	if l.expired(f, now) {
+		delete(l.accounts, account)
+		return nil
+	}
+
+	return f
+}
+
+func (l *memoryLockout) expired(f *failures, now time.Time) bool {
+	return now.After(f.blockedUntil) && now.Sub(f.last) > l.policy.ResetAfter
+}
+
+// RetryAfter formats wait for the Retry-After header in whole seconds, at least 1.
+func RetryAfter(wait time.Duration) string {
+	return strconv.FormatInt(max(int64(math.Ceil(wait.Seconds())), 1), 10)
+}
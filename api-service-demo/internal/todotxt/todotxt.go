@@ -0,0 +1,283 @@
+package todotxt
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/api-service-demo/internal/dto"
+)
+
+const (
+	keyDue      = "due"
+	keyID       = "id"
+	keyPriority = "pri" // keeps the priority of completed tasks, as the format drops "(A)" on completion
+	keyRevision = "rev" // updated_at of the task the line was written from
+)
+
+// Item is a single todo.txt line. Text is what is left of it without
+// the dates, the priority, +project, @contexts and the known key:value pairs.
+// A task has one project, so further +project tags stay in Text.
+type Item struct {
+	ID          string
+	Revision    int64 // 0 if the line was not written by the server
+	Done        bool
+	Priority    byte // 'A'-'Z', 0 if not set
+	CreatedDate time.Time
+	DoneDate    time.Time
+	DueDate     time.Time
+	Project     string
+	Contexts    []string
+	Text        string
+}
+
+func Parse(r io.Reader) ([]Item, error) {
+	var items []Item
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		items = append(items, ParseLine(line))
+	}
+
+	return items, scanner.Err()
+}
+
+// ParseLine parses one line in the todo.txt format:
+//
+//	x (A) 2024-01-02 2024-01-01 text +project @context due:2024-01-10
+func ParseLine(line string) Item {
+	var item Item
+	fields := strings.Fields(line)
+
+	if len(fields) > 0 && fields[0] == "x" {
+		item.Done = true
+		fields = fields[1:]
+	}
+
+	if len(fields) > 0 && isPriority(fields[0]) {
+		item.Priority = fields[0][1]
+		fields = fields[1:]
+	}
+
+	// a completed task has the completion date first, then the creation date
+	if date, ok := parseDate(fields); ok {
+		fields = fields[1:]
+		if item.Done {
+			item.DoneDate = date
+			if date, ok := parseDate(fields); ok {
+				item.CreatedDate = date
+				fields = fields[1:]
+			}
+		} else {
+			item.CreatedDate = date
+		}
+	}
+
+	text := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if len(field) > 1 && field[0] == '+' && item.Project == "" {
+			item.Project = field[1:]
+			continue
+		}
+
+		if len(field) > 1 && field[0] == '@' {
+			item.Contexts = append(item.Contexts, field[1:])
+			continue
+		}
+
+		key, value, ok := strings.Cut(field, ":")
+		if !ok || key == "" || value == "" || strings.HasPrefix(value, "//") {
+			text = append(text, field)
+			continue
+		}
+
+		switch key {
+		case keyDue:
+			due, err := time.Parse(time.DateOnly, value)
+			if err != nil {
+				text = append(text, field)
+				continue
+			}
+			item.DueDate = due
+		case keyID:
+			item.ID = value
+		case keyRevision:
+			revision, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				text = append(text, field)
+				continue
+			}
+			item.Revision = revision
+		case keyPriority:
+			if len(value) == 1 && value[0] >= 'A' && value[0] <= 'Z' {
+				item.Priority = value[0]
+			}
+		default:
+			text = append(text, field)
+		}
+	}
+	item.Text = strings.Join(text, " ")
+
+	return item
+}
+
+func (i Item) String() string {
+	var b strings.Builder
+
+	if i.Done {
+		b.WriteString("x ")
+		if !i.DoneDate.IsZero() {
+			b.WriteString(i.DoneDate.Format(time.DateOnly) + " ")
+			if !i.CreatedDate.IsZero() {
+				b.WriteString(i.CreatedDate.Format(time.DateOnly) + " ")
+			}
+		}
+	} else {
+		if i.Priority != 0 {
+			b.WriteString("(" + string(i.Priority) + ") ")
+		}
+		if !i.CreatedDate.IsZero() {
+			b.WriteString(i.CreatedDate.Format(time.DateOnly) + " ")
+		}
+	}
+
+	b.WriteString(i.Text)
+
+	if i.Project != "" {
+		b.WriteString(" +" + i.Project)
+	}
+
+	for _, context := range i.Contexts {
+		b.WriteString(" @" + context)
+	}
+
+	if !i.DueDate.IsZero() {
+		b.WriteString(" " + keyDue + ":" + i.DueDate.Format(time.DateOnly))
+	}
+
+	if i.Done && i.Priority != 0 {
+		b.WriteString(" " + keyPriority + ":" + string(i.Priority))
+	}
+
+	if i.ID != "" {
+		b.WriteString(" " + keyID + ":" + i.ID)
+	}
+
+	if i.Revision != 0 {
+		b.WriteString(" " + keyRevision + ":" + strconv.FormatInt(i.Revision, 10))
+	}
+
+	return b.String()
+}
+
+func Write(w io.Writer, items []Item) error {
+	for _, item := range items {
+		if _, err := io.WriteString(w, item.String()+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FromTask renders the task's dates in loc, the user's timezone.
+// All-day tasks keep their date wherever the user is.
+func FromTask(t dto.Task, loc *time.Location) Item {
+	item := Item{
+		ID:       t.ID,
+		Revision: t.UpdatedAt,
+		Done:     t.StatusCategory == dto.StatusCategoryDone,
+		Priority: FromTaskPriority(t.Priority),
+		Project:  ProjectTag(t.Project),
+		Contexts: t.Tags,
+		Text:     t.Title,
+	}
+
+	if t.CreatedAt != 0 {
+		item.CreatedDate = time.Unix(t.CreatedAt, 0).In(loc)
+	}
+
+	if t.DueDate != 0 {
+		item.DueDate = time.Unix(t.DueDate, 0).In(loc)
+		if t.AllDay {
+			item.DueDate = time.Unix(t.DueDate, 0).UTC()
+		}
+	}
+
+	return item
+}
+
+// ProjectTag returns the +project tag of a task project. Tags end at a space,
+// so spaces in the project become underscores.
+func ProjectTag(project string) string {
+	return strings.Join(strings.Fields(project), "_")
+}
+
+// Tags returns the @contexts as task tags, which are lower case, sorted and without duplicates.
+func (i Item) Tags() []string {
+	tags := make([]string, 0, len(i.Contexts))
+	for _, context := range i.Contexts {
+		tags = append(tags, strings.ToLower(context))
+	}
+	slices.Sort(tags)
+
+	return slices.Compact(tags)
+}
+
+// ToTaskPriority maps (A) to high, (B) to medium and everything else to low.
+func ToTaskPriority(p byte) dto.TaskPriority {
+	switch p {
+	case 'A':
+		return dto.TaskPriorityHigh
+	case 'B':
+		return dto.TaskPriorityMedium
+	default:
+		return dto.TaskPriorityLow
+	}
+}
+
+func FromTaskPriority(p dto.TaskPriority) byte {
+	switch p {
+	case dto.TaskPriorityHigh:
+		return 'A'
+	case dto.TaskPriorityMedium:
+		return 'B'
+	default:
+		return 'C'
+	}
+}
+
+// DueUnix returns the due date the way all-day tasks store it, its midnight UTC, 0 if not set.
+// todo.txt due dates have no time, so tasks created from them are all-day.
+func (i Item) DueUnix() int64 {
+	if i.DueDate.IsZero() {
+		return 0
+	}
+
+	return time.Date(i.DueDate.Year(), i.DueDate.Month(), i.DueDate.Day(), 0, 0, 0, 0, time.UTC).Unix()
+}
+
+func isPriority(field string) bool {
+	return len(field) == 3 && field[0] == '(' && field[2] == ')' && field[1] >= 'A' && field[1] <= 'Z'
+}
+
+func parseDate(fields []string) (time.Time, bool) {
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.DateOnly, fields[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
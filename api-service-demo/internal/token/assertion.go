@@ -0,0 +1,53 @@
+package token
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// must match Issuer and Audience of database-service's pkg/identity
+const (
+	assertionIssuer   = "api-service"
+	assertionAudience = "database-service"
+)
+
+type assertionSigner struct {
+	secretKey []byte
+	ttl       time.Duration
+}
+
+// AssertionSigner issues short-lived tokens that prove to database-service
+// which user a request is made for and in which workspace.
+type AssertionSigner interface {
+	Sign(userID, workspaceID string) (string, error)
+}
+
+type assertionClaims struct {
+	jwt.RegisteredClaims
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+func NewAssertionSigner(secretKey []byte, ttl time.Duration) AssertionSigner {
+	return &assertionSigner{
+		secretKey: secretKey,
+		ttl:       ttl,
+	}
+}
+
+func (s *assertionSigner) Sign(userID, workspaceID string) (string, error) {
+	now := time.Now()
+	claims := assertionClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    assertionIssuer,
+			Subject:   userID,
+			Audience:  jwt.ClaimStrings{assertionAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+		WorkspaceID: workspaceID,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.secretKey)
+}
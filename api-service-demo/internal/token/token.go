@@ -6,12 +6,15 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// AccessTokenTTL is how long an access token works, the session goes on with its refresh token.
+const AccessTokenTTL = 15 * time.Minute
+
 type jwtService struct {
 	secretKey []byte
 }
 
 type JWTService interface {
-	Generate(userID string) (string, error)
+	Generate(userID, workspaceID, sessionID string) (string, error)
 	Parse(token string) (*jwt.Token, error)
 }
 
@@ -21,10 +24,16 @@ func NewJWTService(secretKey []byte) JWTService {
 	}
 }
 
-func (j *jwtService) Generate(userID string) (string, error) {
+// Generate issues an access token of the session for the user working in the workspace,
+// an empty workspaceID means the user's personal workspace.
+func (j *jwtService) Generate(userID, workspaceID, sessionID string) (string, error) {
 	claims := jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(24 * 7 * time.Hour).Unix(),
+		"user_id":    userID,
+		"session_id": sessionID,
+		"exp":        time.Now().Add(AccessTokenTTL).Unix(),
+	}
+	if workspaceID != "" {
+		claims["workspace_id"] = workspaceID
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
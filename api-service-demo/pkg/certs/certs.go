@@ -0,0 +1,237 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNoCertificate      = errors.New("no certificate loaded")
+	ErrNoPeerCertificate  = errors.New("peer did not present a certificate")
+	ErrIdentityNotAllowed = errors.New("peer identity is not allowed")
+)
+
+type Config struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string // optional, used to verify the peer
+}
+
+// Reloader keeps a key pair and CA pool in memory and swaps them
+// when the files on disk change, so certificates can be rotated without restart.
+type Reloader struct {
+	cfg Config
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func NewReloader(cfg Config) (*Reloader, error) {
+	r := &Reloader{
+		cfg: cfg,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Watch polls the files every interval and reloads them on change.
+// Failed reloads keep the previous certificates and are reported to onErr.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration, onErr func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			modTime, err := r.lastModTime()
+			if err != nil {
+				onErr(err)
+				continue
+			}
+
+			r.mu.RLock()
+			changed := modTime.After(r.modTime)
+			r.mu.RUnlock()
+
+			if !changed {
+				continue
+			}
+
+			if err := r.reload(); err != nil {
+				onErr(err)
+			}
+		}
+	}
+}
+
+func (r *Reloader) reload() error {
+	modTime, err := r.lastModTime()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if r.cfg.CAFile != "" {
+		caPEM, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("no certificates found in %s", r.cfg.CAFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.pool = pool
+	r.modTime = modTime
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *Reloader) lastModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if path == "" {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest, nil
+}
+
+func (r *Reloader) certificate() (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.cert == nil {
+		return nil, ErrNoCertificate
+	}
+
+	return r.cert, nil
+}
+
+func (r *Reloader) caPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.pool
+}
+
+// ServerConfig returns a TLS config for a server. When a CA file is configured
+// clients must present a certificate signed by it, and if allowedIdentities
+// is not empty the certificate CN or one of its DNS names must be in the list.
+func (r *Reloader) ServerConfig(allowedIdentities []string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := r.certificate()
+			if err != nil {
+				return nil, err
+			}
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+
+			if pool := r.caPool(); pool != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+				cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+					return verifyIdentity(cs, allowedIdentities)
+				}
+			}
+
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns a TLS config for a client that presents its own
+// certificate and verifies the server against the current CA pool.
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.certificate()
+		},
+		// the CA pool can change at runtime, so verification is done
+		// manually in VerifyConnection instead of through RootCAs
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return ErrNoPeerCertificate
+			}
+
+			opts := x509.VerifyOptions{
+				Roots:         r.caPool(),
+				DNSName:       cs.ServerName,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}
+
+func verifyIdentity(cs tls.ConnectionState, allowedIdentities []string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return ErrNoPeerCertificate
+	}
+
+	if len(allowedIdentities) == 0 {
+		return nil
+	}
+
+	leaf := cs.PeerCertificates[0]
+	if slices.Contains(allowedIdentities, leaf.Subject.CommonName) {
+		return nil
+	}
+
+	for _, name := range leaf.DNSNames {
+		if slices.Contains(allowedIdentities, name) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrIdentityNotAllowed, leaf.Subject.CommonName)
+}
@@ -22,6 +22,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// statuses of the default workflow, custom workflow statuses use other values
 type TaskStatus int32
 
 const (
@@ -123,9 +124,16 @@ func (TaskPriority) EnumDescriptor() ([]byte, []int) {
 type SortField int32
 
 const (
-	SortField_PRIORITY   SortField = 0
-	SortField_DUE_DATE   SortField = 1
-	SortField_CREATED_AT SortField = 2
+	SortField_PRIORITY     SortField = 0
+	SortField_DUE_DATE     SortField = 1
+	SortField_CREATED_AT   SortField = 2
+	SortField_TITLE        SortField = 3
+	SortField_STATUS       SortField = 4
+	SortField_UPDATED_AT   SortField = 5
+	SortField_COMPLETED_AT SortField = 6
+	SortField_ESTIMATE     SortField = 7
+	SortField_STORY_POINTS SortField = 8
+	SortField_MANUAL       SortField = 9 // the order the owner arranged the tasks in
 )
 
 // Enum value maps for SortField.
@@ -134,11 +142,25 @@ var (
 		0: "PRIORITY",
 		1: "DUE_DATE",
 		2: "CREATED_AT",
+		3: "TITLE",
+		4: "STATUS",
+		5: "UPDATED_AT",
+		6: "COMPLETED_AT",
+		7: "ESTIMATE",
+		8: "STORY_POINTS",
+		9: "MANUAL",
 	}
 	SortField_value = map[string]int32{
-		"PRIORITY":   0,
-		"DUE_DATE":   1,
-		"CREATED_AT": 2,
+		"PRIORITY":     0,
+		"DUE_DATE":     1,
+		"CREATED_AT":   2,
+		"TITLE":        3,
+		"STATUS":       4,
+		"UPDATED_AT":   5,
+		"COMPLETED_AT": 6,
+		"ESTIMATE":     7,
+		"STORY_POINTS": 8,
+		"MANUAL":       9,
 	}
 )
 
@@ -215,11 +237,254 @@ func (SortDirection) EnumDescriptor() ([]byte, []int) {
 	return file_todo_proto_rawDescGZIP(), []int{3}
 }
 
+// where tasks without a value (no due date, not completed) go
+type NullsOrder int32
+
+const (
+	NullsOrder_NULLS_LAST  NullsOrder = 0
+	NullsOrder_NULLS_FIRST NullsOrder = 1
+)
+
+// Enum value maps for NullsOrder.
+var (
+	NullsOrder_name = map[int32]string{
+		0: "NULLS_LAST",
+		1: "NULLS_FIRST",
+	}
+	NullsOrder_value = map[string]int32{
+		"NULLS_LAST":  0,
+		"NULLS_FIRST": 1,
+	}
+)
+
+func (x NullsOrder) Enum() *NullsOrder {
+	p := new(NullsOrder)
+	*p = x
+	return p
+}
+
+func (x NullsOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NullsOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_proto_enumTypes[4].Descriptor()
+}
+
+func (NullsOrder) Type() protoreflect.EnumType {
+	return &file_todo_proto_enumTypes[4]
+}
+
+func (x NullsOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NullsOrder.Descriptor instead.
+func (NullsOrder) EnumDescriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{4}
+}
+
+type StatsBucket int32
+
+const (
+	StatsBucket_BUCKET_DAY  StatsBucket = 0
+	StatsBucket_BUCKET_WEEK StatsBucket = 1 // weeks start on monday
+)
+
+// Enum value maps for StatsBucket.
+var (
+	StatsBucket_name = map[int32]string{
+		0: "BUCKET_DAY",
+		1: "BUCKET_WEEK",
+	}
+	StatsBucket_value = map[string]int32{
+		"BUCKET_DAY":  0,
+		"BUCKET_WEEK": 1,
+	}
+)
+
+func (x StatsBucket) Enum() *StatsBucket {
+	p := new(StatsBucket)
+	*p = x
+	return p
+}
+
+func (x StatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_proto_enumTypes[5].Descriptor()
+}
+
+func (StatsBucket) Type() protoreflect.EnumType {
+	return &file_todo_proto_enumTypes[5]
+}
+
+func (x StatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsBucket.Descriptor instead.
+func (StatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{5}
+}
+
+type StatusCategory int32
+
+const (
+	StatusCategory_CATEGORY_TODO   StatusCategory = 0
+	StatusCategory_CATEGORY_ACTIVE StatusCategory = 1
+	StatusCategory_CATEGORY_DONE   StatusCategory = 2
+)
+
+// Enum value maps for StatusCategory.
+var (
+	StatusCategory_name = map[int32]string{
+		0: "CATEGORY_TODO",
+		1: "CATEGORY_ACTIVE",
+		2: "CATEGORY_DONE",
+	}
+	StatusCategory_value = map[string]int32{
+		"CATEGORY_TODO":   0,
+		"CATEGORY_ACTIVE": 1,
+		"CATEGORY_DONE":   2,
+	}
+)
+
+func (x StatusCategory) Enum() *StatusCategory {
+	p := new(StatusCategory)
+	*p = x
+	return p
+}
+
+func (x StatusCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_proto_enumTypes[6].Descriptor()
+}
+
+func (StatusCategory) Type() protoreflect.EnumType {
+	return &file_todo_proto_enumTypes[6]
+}
+
+func (x StatusCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusCategory.Descriptor instead.
+func (StatusCategory) EnumDescriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{6}
+}
+
+// viewers can read the task, editors can also change it and track time on it,
+// owners can also delete and share it
+type TaskRole int32
+
+const (
+	TaskRole_VIEWER TaskRole = 0
+	TaskRole_EDITOR TaskRole = 1
+	TaskRole_OWNER  TaskRole = 2
+)
+
+// Enum value maps for TaskRole.
+var (
+	TaskRole_name = map[int32]string{
+		0: "VIEWER",
+		1: "EDITOR",
+		2: "OWNER",
+	}
+	TaskRole_value = map[string]int32{
+		"VIEWER": 0,
+		"EDITOR": 1,
+		"OWNER":  2,
+	}
+)
+
+func (x TaskRole) Enum() *TaskRole {
+	p := new(TaskRole)
+	*p = x
+	return p
+}
+
+func (x TaskRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_proto_enumTypes[7].Descriptor()
+}
+
+func (TaskRole) Type() protoreflect.EnumType {
+	return &file_todo_proto_enumTypes[7]
+}
+
+func (x TaskRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRole.Descriptor instead.
+func (TaskRole) EnumDescriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{7}
+}
+
+// members work on their tasks in the workspace, admins can also invite users,
+// the owner created it and can't leave it
+type WorkspaceRole int32
+
+const (
+	WorkspaceRole_MEMBER          WorkspaceRole = 0
+	WorkspaceRole_ADMIN           WorkspaceRole = 1
+	WorkspaceRole_WORKSPACE_OWNER WorkspaceRole = 2
+)
+
+// Enum value maps for WorkspaceRole.
+var (
+	WorkspaceRole_name = map[int32]string{
+		0: "MEMBER",
+		1: "ADMIN",
+		2: "WORKSPACE_OWNER",
+	}
+	WorkspaceRole_value = map[string]int32{
+		"MEMBER":          0,
+		"ADMIN":           1,
+		"WORKSPACE_OWNER": 2,
+	}
+)
+
+func (x WorkspaceRole) Enum() *WorkspaceRole {
+	p := new(WorkspaceRole)
+	*p = x
+	return p
+}
+
+func (x WorkspaceRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_proto_enumTypes[8].Descriptor()
+}
+
+func (WorkspaceRole) Type() protoreflect.EnumType {
+	return &file_todo_proto_enumTypes[8]
+}
+
+func (x WorkspaceRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceRole.Descriptor instead.
+func (WorkspaceRole) EnumDescriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{8}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	PasswordHash  string                 `protobuf:"bytes,3,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -268,13 +533,6 @@ func (x *User) GetUsername() string {
 	return ""
 }
 
-func (x *User) GetPasswordHash() string {
-	if x != nil {
-		return x.PasswordHash
-	}
-	return ""
-}
-
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -459,27 +717,29 @@ func (x *GetUserByUsernameResponse) GetUser() *User {
 	return nil
 }
 
-type DeleteUserByIDRequest struct {
+// checks the user's password on login
+type AuthenticateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserByIDRequest) Reset() {
-	*x = DeleteUserByIDRequest{}
+func (x *AuthenticateRequest) Reset() {
+	*x = AuthenticateRequest{}
 	mi := &file_todo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserByIDRequest) String() string {
+func (x *AuthenticateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserByIDRequest) ProtoMessage() {}
+func (*AuthenticateRequest) ProtoMessage() {}
 
-func (x *DeleteUserByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,38 +751,46 @@ func (x *DeleteUserByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserByIDRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
 	return file_todo_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteUserByIDRequest) GetId() string {
+func (x *AuthenticateRequest) GetUsername() string {
 	if x != nil {
-		return x.Id
+		return x.Username
 	}
 	return ""
 }
 
-type DeleteUserByIDResponse struct {
+func (x *AuthenticateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AuthenticateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserByIDResponse) Reset() {
-	*x = DeleteUserByIDResponse{}
+func (x *AuthenticateResponse) Reset() {
+	*x = AuthenticateResponse{}
 	mi := &file_todo_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserByIDResponse) String() string {
+func (x *AuthenticateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserByIDResponse) ProtoMessage() {}
+func (*AuthenticateResponse) ProtoMessage() {}
 
-func (x *DeleteUserByIDResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,39 +802,39 @@ func (x *DeleteUserByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserByIDResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserByIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
 	return file_todo_proto_rawDescGZIP(), []int{6}
 }
 
-type Task struct {
+func (x *AuthenticateResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeleteUserByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=todo.TaskStatus" json:"status,omitempty"`
-	Priority      TaskPriority           `protobuf:"varint,6,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
-	DueDate       int64                  `protobuf:"varint,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *DeleteUserByIDRequest) Reset() {
+	*x = DeleteUserByIDRequest{}
 	mi := &file_todo_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *DeleteUserByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*DeleteUserByIDRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,91 +846,38 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserByIDRequest) Descriptor() ([]byte, []int) {
 	return file_todo_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Task) GetId() string {
+func (x *DeleteUserByIDRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Task) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *Task) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Task) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Task) GetStatus() TaskStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TaskStatus_TODO
-}
-
-func (x *Task) GetPriority() TaskPriority {
-	if x != nil {
-		return x.Priority
-	}
-	return TaskPriority_LOW
-}
-
-func (x *Task) GetDueDate() int64 {
-	if x != nil {
-		return x.DueDate
-	}
-	return 0
-}
-
-func (x *Task) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-type CreateTaskRequest struct {
+type DeleteUserByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Priority      TaskPriority           `protobuf:"varint,3,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
-	DueDate       int64                  `protobuf:"varint,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
+func (x *DeleteUserByIDResponse) Reset() {
+	*x = DeleteUserByIDResponse{}
 	mi := &file_todo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *DeleteUserByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*DeleteUserByIDResponse) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,61 +889,5570 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserByIDResponse) Descriptor() ([]byte, []int) {
 	return file_todo_proto_rawDescGZIP(), []int{8}
 }
 
+type GetUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsRequest) Reset() {
+	*x = GetUserSettingsRequest{}
+	mi := &file_todo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{9}
+}
+
+type GetUserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name, empty means UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsResponse) Reset() {
+	*x = GetUserSettingsResponse{}
+	mi := &file_todo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsResponse) ProtoMessage() {}
+
+func (x *GetUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserSettingsResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// due dates of all-day tasks and overdue tasks follow the user's timezone
+type UpdateUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsRequest) Reset() {
+	*x = UpdateUserSettingsRequest{}
+	mi := &file_todo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateUserSettingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type UpdateUserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsResponse) Reset() {
+	*x = UpdateUserSettingsResponse{}
+	mi := &file_todo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateUserSettingsResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Task struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status         TaskStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=todo.TaskStatus" json:"status,omitempty"`
+	Priority       TaskPriority           `protobuf:"varint,6,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
+	DueDate        int64                  `protobuf:"varint,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Project        string                 `protobuf:"bytes,9,opt,name=project,proto3" json:"project,omitempty"` // empty for none
+	UpdatedAt      int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    int64                  `protobuf:"varint,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // 0 if not done
+	StartedAt      int64                  `protobuf:"varint,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`       // 0 if todo
+	StatusCategory StatusCategory         `protobuf:"varint,13,opt,name=status_category,json=statusCategory,proto3,enum=todo.StatusCategory" json:"status_category,omitempty"`
+	// due_date is the midnight UTC of the due date, the task is due by the end of that day for the user
+	AllDay          bool     `protobuf:"varint,14,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	Overdue         bool     `protobuf:"varint,15,opt,name=overdue,proto3" json:"overdue,omitempty"`                                        // open and past due_date when the task was read
+	TrackedSeconds  int64    `protobuf:"varint,16,opt,name=tracked_seconds,json=trackedSeconds,proto3" json:"tracked_seconds,omitempty"`    // total of the task's time entries, a running timer counts until now
+	EstimateMinutes uint32   `protobuf:"varint,17,opt,name=estimate_minutes,json=estimateMinutes,proto3" json:"estimate_minutes,omitempty"` // 0 if not estimated
+	StoryPoints     uint32   `protobuf:"varint,18,opt,name=story_points,json=storyPoints,proto3" json:"story_points,omitempty"`             // 0 if not sized
+	AssigneeId      string   `protobuf:"bytes,19,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`                 // empty if unassigned
+	Rank            string   `protobuf:"bytes,20,opt,name=rank,proto3" json:"rank,omitempty"`                                               // place in the owner's manual order, ranks compare byte-wise
+	ParentId        string   `protobuf:"bytes,21,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                       // the task this one is a subtask of, empty for top-level tasks
+	Tags            []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_todo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TODO
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *Task) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *Task) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Task) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *Task) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Task) GetStatusCategory() StatusCategory {
+	if x != nil {
+		return x.StatusCategory
+	}
+	return StatusCategory_CATEGORY_TODO
+}
+
+func (x *Task) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *Task) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *Task) GetTrackedSeconds() int64 {
+	if x != nil {
+		return x.TrackedSeconds
+	}
+	return 0
+}
+
+func (x *Task) GetEstimateMinutes() uint32 {
+	if x != nil {
+		return x.EstimateMinutes
+	}
+	return 0
+}
+
+func (x *Task) GetStoryPoints() uint32 {
+	if x != nil {
+		return x.StoryPoints
+	}
+	return 0
+}
+
+func (x *Task) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Task) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Task) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority        TaskPriority           `protobuf:"varint,3,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
+	DueDate         int64                  `protobuf:"varint,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                         // 0 for no due date, otherwise in the future
+	Project         string                 `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`                                         // at most 64 characters, empty for none
+	AllDay          bool                   `protobuf:"varint,6,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                            // only the UTC date of due_date is kept
+	EstimateMinutes uint32                 `protobuf:"varint,7,opt,name=estimate_minutes,json=estimateMinutes,proto3" json:"estimate_minutes,omitempty"` // at most 120000
+	StoryPoints     uint32                 `protobuf:"varint,8,opt,name=story_points,json=storyPoints,proto3" json:"story_points,omitempty"`             // at most 100
+	Tags            []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                               // at most 10, lowercase without spaces or commas
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_todo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{14}
+}
+
 func (x *CreateTaskRequest) GetTitle() string {
 	if x != nil {
-		return x.Title
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *CreateTaskRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *CreateTaskRequest) GetEstimateMinutes() uint32 {
+	if x != nil {
+		return x.EstimateMinutes
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetStoryPoints() uint32 {
+	if x != nil {
+		return x.StoryPoints
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_todo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_todo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_todo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type Filters struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskStatuses   []TaskStatus           `protobuf:"varint,1,rep,packed,name=taskStatuses,proto3,enum=todo.TaskStatus" json:"taskStatuses,omitempty"`
+	TaskPriorities []TaskPriority         `protobuf:"varint,2,rep,packed,name=taskPriorities,proto3,enum=todo.TaskPriority" json:"taskPriorities,omitempty"`
+	// date (YYYY-MM-DD) or RFC3339 time, both bounds are inclusive
+	// and dates cover the whole day in the timezone
+	DueBefore     string `protobuf:"bytes,3,opt,name=dueBefore,proto3" json:"dueBefore,omitempty"`
+	DueAfter      string `protobuf:"bytes,4,opt,name=dueAfter,proto3" json:"dueAfter,omitempty"`
+	CreatedBefore string `protobuf:"bytes,5,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	CreatedAfter  string `protobuf:"bytes,6,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	// not done tasks with a due date in the past
+	OverdueOnly bool  `protobuf:"varint,7,opt,name=overdueOnly,proto3" json:"overdueOnly,omitempty"`
+	HasDueDate  *bool `protobuf:"varint,8,opt,name=hasDueDate,proto3,oneof" json:"hasDueDate,omitempty"`
+	// IANA name like "Europe/Berlin", UTC if empty
+	Timezone    string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	HasEstimate *bool  `protobuf:"varint,10,opt,name=hasEstimate,proto3,oneof" json:"hasEstimate,omitempty"`
+	// estimated tasks expected to take at most that many minutes, 0 for no limit
+	MaxEstimateMinutes uint32   `protobuf:"varint,11,opt,name=maxEstimateMinutes,proto3" json:"maxEstimateMinutes,omitempty"`
+	StoryPoints        []uint32 `protobuf:"varint,12,rep,packed,name=storyPoints,proto3" json:"storyPoints,omitempty"`
+	// tasks other users shared with the caller instead of the caller's own
+	SharedWithMe bool `protobuf:"varint,13,opt,name=sharedWithMe,proto3" json:"sharedWithMe,omitempty"`
+	// tasks assigned to the caller instead of the caller's own,
+	// together with the shared ones if sharedWithMe is also set
+	AssignedToMe bool `protobuf:"varint,14,opt,name=assignedToMe,proto3" json:"assignedToMe,omitempty"`
+	// tasks assigned to the user with that id
+	AssigneeId    string `protobuf:"bytes,15,opt,name=assigneeId,proto3" json:"assigneeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filters) Reset() {
+	*x = Filters{}
+	mi := &file_todo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filters) ProtoMessage() {}
+
+func (x *Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
+func (*Filters) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Filters) GetTaskStatuses() []TaskStatus {
+	if x != nil {
+		return x.TaskStatuses
+	}
+	return nil
+}
+
+func (x *Filters) GetTaskPriorities() []TaskPriority {
+	if x != nil {
+		return x.TaskPriorities
+	}
+	return nil
+}
+
+func (x *Filters) GetDueBefore() string {
+	if x != nil {
+		return x.DueBefore
+	}
+	return ""
+}
+
+func (x *Filters) GetDueAfter() string {
+	if x != nil {
+		return x.DueAfter
+	}
+	return ""
+}
+
+func (x *Filters) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *Filters) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *Filters) GetOverdueOnly() bool {
+	if x != nil {
+		return x.OverdueOnly
+	}
+	return false
+}
+
+func (x *Filters) GetHasDueDate() bool {
+	if x != nil && x.HasDueDate != nil {
+		return *x.HasDueDate
+	}
+	return false
+}
+
+func (x *Filters) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Filters) GetHasEstimate() bool {
+	if x != nil && x.HasEstimate != nil {
+		return *x.HasEstimate
+	}
+	return false
+}
+
+func (x *Filters) GetMaxEstimateMinutes() uint32 {
+	if x != nil {
+		return x.MaxEstimateMinutes
+	}
+	return 0
+}
+
+func (x *Filters) GetStoryPoints() []uint32 {
+	if x != nil {
+		return x.StoryPoints
+	}
+	return nil
+}
+
+func (x *Filters) GetSharedWithMe() bool {
+	if x != nil {
+		return x.SharedWithMe
+	}
+	return false
+}
+
+func (x *Filters) GetAssignedToMe() bool {
+	if x != nil {
+		return x.AssignedToMe
+	}
+	return false
+}
+
+func (x *Filters) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+type OrderBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         SortField              `protobuf:"varint,1,opt,name=field,proto3,enum=todo.SortField" json:"field,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=todo.SortDirection" json:"direction,omitempty"`
+	Nulls         NullsOrder             `protobuf:"varint,3,opt,name=nulls,proto3,enum=todo.NullsOrder" json:"nulls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBy) Reset() {
+	*x = OrderBy{}
+	mi := &file_todo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBy) ProtoMessage() {}
+
+func (x *OrderBy) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBy.ProtoReflect.Descriptor instead.
+func (*OrderBy) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrderBy) GetField() SortField {
+	if x != nil {
+		return x.Field
+	}
+	return SortField_PRIORITY
+}
+
+func (x *OrderBy) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_ASC
+}
+
+func (x *OrderBy) GetNulls() NullsOrder {
+	if x != nil {
+		return x.Nulls
+	}
+	return NullsOrder_NULLS_LAST
+}
+
+type GetTasksRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PageSize   int64                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int64                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	Filters    *Filters               `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	OrderBy    *OrderBy               `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	Title      *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// sort keys by precedence, replaces order_by when set
+	SortKeys      []*OrderBy `protobuf:"bytes,6,rep,name=sort_keys,json=sortKeys,proto3" json:"sort_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksRequest) Reset() {
+	*x = GetTasksRequest{}
+	mi := &file_todo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksRequest) ProtoMessage() {}
+
+func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTasksRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetOrderBy() *OrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetSortKeys() []*OrderBy {
+	if x != nil {
+		return x.SortKeys
+	}
+	return nil
+}
+
+type GetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksResponse) Reset() {
+	*x = GetTasksResponse{}
+	mi := &file_todo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksResponse) ProtoMessage() {}
+
+func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTasksResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetTasksResponse) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type UpdateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status          *TaskStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=todo.TaskStatus,oneof" json:"status,omitempty"`
+	Priority        *TaskPriority          `protobuf:"varint,5,opt,name=priority,proto3,enum=todo.TaskPriority,oneof" json:"priority,omitempty"`
+	DueDate         *int64                 `protobuf:"varint,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"` // may be in the past, 0 clears it
+	Project         *string                `protobuf:"bytes,7,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	AllDay          *bool                  `protobuf:"varint,8,opt,name=all_day,json=allDay,proto3,oneof" json:"all_day,omitempty"`
+	EstimateMinutes *uint32                `protobuf:"varint,9,opt,name=estimate_minutes,json=estimateMinutes,proto3,oneof" json:"estimate_minutes,omitempty"` // 0 removes the estimate
+	StoryPoints     *uint32                `protobuf:"varint,10,opt,name=story_points,json=storyPoints,proto3,oneof" json:"story_points,omitempty"`            // 0 removes the story points
+	Tags            *TaskTags              `protobuf:"bytes,11,opt,name=tags,proto3,oneof" json:"tags,omitempty"`                                              // empty removes the tags
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_todo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TODO
+}
+
+func (x *UpdateTaskRequest) GetPriority() TaskPriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *UpdateTaskRequest) GetDueDate() int64 {
+	if x != nil && x.DueDate != nil {
+		return *x.DueDate
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetAllDay() bool {
+	if x != nil && x.AllDay != nil {
+		return *x.AllDay
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetEstimateMinutes() uint32 {
+	if x != nil && x.EstimateMinutes != nil {
+		return *x.EstimateMinutes
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetStoryPoints() uint32 {
+	if x != nil && x.StoryPoints != nil {
+		return *x.StoryPoints
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetTags() *TaskTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// TaskTags wraps the tags so updates can tell unchanged tags from removed ones
+type TaskTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTags) Reset() {
+	*x = TaskTags{}
+	mi := &file_todo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTags) ProtoMessage() {}
+
+func (x *TaskTags) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTags.ProtoReflect.Descriptor instead.
+func (*TaskTags) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_todo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DeleteTasksByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTasksByIDRequest) Reset() {
+	*x = DeleteTasksByIDRequest{}
+	mi := &file_todo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTasksByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTasksByIDRequest) ProtoMessage() {}
+
+func (x *DeleteTasksByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTasksByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTasksByIDRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteTasksByIDRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteTasksByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTasksByIDResponse) Reset() {
+	*x = DeleteTasksByIDResponse{}
+	mi := &file_todo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTasksByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTasksByIDResponse) ProtoMessage() {}
+
+func (x *DeleteTasksByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTasksByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTasksByIDResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{26}
+}
+
+type TaskPatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *TaskStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=todo.TaskStatus,oneof" json:"status,omitempty"`
+	Priority      *TaskPriority          `protobuf:"varint,2,opt,name=priority,proto3,enum=todo.TaskPriority,oneof" json:"priority,omitempty"`
+	DueDate       *int64                 `protobuf:"varint,3,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Project       *string                `protobuf:"bytes,4,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskPatch) Reset() {
+	*x = TaskPatch{}
+	mi := &file_todo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskPatch) ProtoMessage() {}
+
+func (x *TaskPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskPatch.ProtoReflect.Descriptor instead.
+func (*TaskPatch) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TaskPatch) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TODO
+}
+
+func (x *TaskPatch) GetPriority() TaskPriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *TaskPatch) GetDueDate() int64 {
+	if x != nil && x.DueDate != nil {
+		return *x.DueDate
+	}
+	return 0
+}
+
+func (x *TaskPatch) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+// either ids or filters selects the tasks to update
+type BulkUpdateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Filters       *Filters               `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Patch         *TaskPatch             `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateTasksRequest) Reset() {
+	*x = BulkUpdateTasksRequest{}
+	mi := &file_todo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTasksRequest) ProtoMessage() {}
+
+func (x *BulkUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkUpdateTasksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkUpdateTasksRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *BulkUpdateTasksRequest) GetPatch() *TaskPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+type BulkUpdateTaskResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Updated       bool                   `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateTaskResult) Reset() {
+	*x = BulkUpdateTaskResult{}
+	mi := &file_todo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTaskResult) ProtoMessage() {}
+
+func (x *BulkUpdateTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTaskResult.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTaskResult) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkUpdateTaskResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkUpdateTaskResult) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *BulkUpdateTaskResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkUpdateTasksResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*BulkUpdateTaskResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	UpdatedCount  int64                   `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateTasksResponse) Reset() {
+	*x = BulkUpdateTasksResponse{}
+	mi := &file_todo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTasksResponse) ProtoMessage() {}
+
+func (x *BulkUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkUpdateTasksResponse) GetResults() []*BulkUpdateTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateTasksResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+// raw field values, parsed and validated by the database service
+type ImportTaskRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Priority      string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	DueDate       string                 `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Project       string                 `protobuf:"bytes,7,opt,name=project,proto3" json:"project,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTaskRow) Reset() {
+	*x = ImportTaskRow{}
+	mi := &file_todo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTaskRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTaskRow) ProtoMessage() {}
+
+func (x *ImportTaskRow) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTaskRow.ProtoReflect.Descriptor instead.
+func (*ImportTaskRow) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportTaskRow) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportTaskRow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ImportTaskRow) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// dry_run is read from the first message of the stream
+type ImportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Rows          []*ImportTaskRow       `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_todo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportTasksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTasksRequest) GetRows() []*ImportTaskRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_todo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportRowResult) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *ImportRowResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportTasksResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*ImportRowResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount   int64                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	DuplicateCount int64                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	FailedCount    int64                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_todo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksResponse) ProtoMessage() {}
+
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportTasksResponse) GetResults() []*ImportRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetCreatedCount() int64 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetDuplicateCount() int64 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type GetTaskStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// date (YYYY-MM-DD) or RFC3339 time, the last 30 days if empty
+	From   string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Bucket StatsBucket `protobuf:"varint,3,opt,name=bucket,proto3,enum=todo.StatsBucket" json:"bucket,omitempty"`
+	// IANA name used for dates and buckets, UTC if empty
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_todo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsRequest) ProtoMessage() {}
+
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTaskStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetBucket() StatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return StatsBucket_BUCKET_DAY
+}
+
+func (x *GetTaskStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type StatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        TaskStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=todo.TaskStatus" json:"status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	mi := &file_todo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StatusCount) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TODO
+}
+
+func (x *StatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriorityCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priority      TaskPriority           `protobuf:"varint,1,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriorityCount) Reset() {
+	*x = PriorityCount{}
+	mi := &file_todo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityCount) ProtoMessage() {}
+
+func (x *PriorityCount) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityCount.ProtoReflect.Descriptor instead.
+func (*PriorityCount) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PriorityCount) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *PriorityCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CompletedBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // unix time of the bucket start
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletedBucket) Reset() {
+	*x = CompletedBucket{}
+	mi := &file_todo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletedBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletedBucket) ProtoMessage() {}
+
+func (x *CompletedBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletedBucket.ProtoReflect.Descriptor instead.
+func (*CompletedBucket) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CompletedBucket) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *CompletedBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// open and overdue counts are current, completions are within the range
+type GetTaskStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OpenByStatus   []*StatusCount         `protobuf:"bytes,1,rep,name=open_by_status,json=openByStatus,proto3" json:"open_by_status,omitempty"`
+	OpenByPriority []*PriorityCount       `protobuf:"bytes,2,rep,name=open_by_priority,json=openByPriority,proto3" json:"open_by_priority,omitempty"`
+	OverdueCount   int64                  `protobuf:"varint,3,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`
+	Completed      []*CompletedBucket     `protobuf:"bytes,4,rep,name=completed,proto3" json:"completed,omitempty"`
+	CompletedCount int64                  `protobuf:"varint,5,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	// lead time, average time from creation to done of the completed tasks
+	AvgCompletionSeconds int64 `protobuf:"varint,6,opt,name=avg_completion_seconds,json=avgCompletionSeconds,proto3" json:"avg_completion_seconds,omitempty"`
+	// cycle time, average time from start to done of the completed tasks
+	AvgCycleTimeSeconds int64 `protobuf:"varint,7,opt,name=avg_cycle_time_seconds,json=avgCycleTimeSeconds,proto3" json:"avg_cycle_time_seconds,omitempty"`
+	// remaining effort, the sums over the open tasks
+	OpenEstimateMinutes int64 `protobuf:"varint,8,opt,name=open_estimate_minutes,json=openEstimateMinutes,proto3" json:"open_estimate_minutes,omitempty"`
+	OpenStoryPoints     int64 `protobuf:"varint,9,opt,name=open_story_points,json=openStoryPoints,proto3" json:"open_story_points,omitempty"`
+	// story points of the tasks completed within the range
+	CompletedStoryPoints int64 `protobuf:"varint,10,opt,name=completed_story_points,json=completedStoryPoints,proto3" json:"completed_story_points,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsResponse) Reset() {
+	*x = GetTaskStatsResponse{}
+	mi := &file_todo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsResponse) ProtoMessage() {}
+
+func (x *GetTaskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTaskStatsResponse) GetOpenByStatus() []*StatusCount {
+	if x != nil {
+		return x.OpenByStatus
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetOpenByPriority() []*PriorityCount {
+	if x != nil {
+		return x.OpenByPriority
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetOverdueCount() int64 {
+	if x != nil {
+		return x.OverdueCount
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetCompleted() []*CompletedBucket {
+	if x != nil {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetCompletedCount() int64 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetAvgCompletionSeconds() int64 {
+	if x != nil {
+		return x.AvgCompletionSeconds
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetAvgCycleTimeSeconds() int64 {
+	if x != nil {
+		return x.AvgCycleTimeSeconds
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetOpenEstimateMinutes() int64 {
+	if x != nil {
+		return x.OpenEstimateMinutes
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetOpenStoryPoints() int64 {
+	if x != nil {
+		return x.OpenStoryPoints
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetCompletedStoryPoints() int64 {
+	if x != nil {
+		return x.CompletedStoryPoints
+	}
+	return 0
+}
+
+// creates the user's calendar feed token, replacing the previous one
+type CreateCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_todo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
+
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{40}
+}
+
+type CreateCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedResponse) Reset() {
+	*x = CreateCalendarFeedResponse{}
+	mi := &file_todo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedResponse) ProtoMessage() {}
+
+func (x *CreateCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateCalendarFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_todo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{42}
+}
+
+type RevokeCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedResponse) Reset() {
+	*x = RevokeCalendarFeedResponse{}
+	mi := &file_todo_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedResponse) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{43}
+}
+
+type ResolveCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCalendarFeedRequest) Reset() {
+	*x = ResolveCalendarFeedRequest{}
+	mi := &file_todo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCalendarFeedRequest) ProtoMessage() {}
+
+func (x *ResolveCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResolveCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResolveCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCalendarFeedResponse) Reset() {
+	*x = ResolveCalendarFeedResponse{}
+	mi := &file_todo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCalendarFeedResponse) ProtoMessage() {}
+
+func (x *ResolveCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResolveCalendarFeedResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type WorkflowStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      StatusCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=todo.StatusCategory" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowStatus) Reset() {
+	*x = WorkflowStatus{}
+	mi := &file_todo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowStatus) ProtoMessage() {}
+
+func (x *WorkflowStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowStatus.ProtoReflect.Descriptor instead.
+func (*WorkflowStatus) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WorkflowStatus) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkflowStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowStatus) GetCategory() StatusCategory {
+	if x != nil {
+		return x.Category
+	}
+	return StatusCategory_CATEGORY_TODO
+}
+
+type WorkflowTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint32                 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            uint32                 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowTransition) Reset() {
+	*x = WorkflowTransition{}
+	mi := &file_todo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTransition) ProtoMessage() {}
+
+func (x *WorkflowTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTransition.ProtoReflect.Descriptor instead.
+func (*WorkflowTransition) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WorkflowTransition) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *WorkflowTransition) GetTo() uint32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// statuses are ordered, new tasks start in the first one.
+// no transitions means any move between statuses is allowed
+type Workflow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*WorkflowStatus      `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions   []*WorkflowTransition  `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
+	mi := &file_todo_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workflow) ProtoMessage() {}
+
+func (x *Workflow) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Workflow) GetStatuses() []*WorkflowStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Workflow) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *Workflow) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type GetWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowRequest) Reset() {
+	*x = GetWorkflowRequest{}
+	mi := &file_todo_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowRequest) ProtoMessage() {}
+
+func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{49}
+}
+
+type GetWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowResponse) Reset() {
+	*x = GetWorkflowResponse{}
+	mi := &file_todo_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowResponse) ProtoMessage() {}
+
+func (x *GetWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetWorkflowResponse) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// replaces the user's workflow, no statuses resets it to the default one
+type UpdateWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*WorkflowStatus      `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions   []*WorkflowTransition  `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkflowRequest) Reset() {
+	*x = UpdateWorkflowRequest{}
+	mi := &file_todo_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkflowRequest) ProtoMessage() {}
+
+func (x *UpdateWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateWorkflowRequest) GetStatuses() []*WorkflowStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowRequest) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type UpdateWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkflowResponse) Reset() {
+	*x = UpdateWorkflowResponse{}
+	mi := &file_todo_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkflowResponse) ProtoMessage() {}
+
+func (x *UpdateWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateWorkflowResponse) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// time spent on a task, stopped_at is 0 while the timer runs
+type TimeEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Project       string                 `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"` // free-form label the time is billed to
+	StartedAt     int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt     int64                  `protobuf:"varint,5,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	Seconds       int64                  `protobuf:"varint,6,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeEntry) Reset() {
+	*x = TimeEntry{}
+	mi := &file_todo_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEntry) ProtoMessage() {}
+
+func (x *TimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEntry.ProtoReflect.Descriptor instead.
+func (*TimeEntry) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TimeEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetStoppedAt() int64 {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// starts a timer on the task, a running timer of the user is stopped first
+type StartTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerRequest) Reset() {
+	*x = StartTimerRequest{}
+	mi := &file_todo_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerRequest) ProtoMessage() {}
+
+func (x *StartTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerRequest.ProtoReflect.Descriptor instead.
+func (*StartTimerRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StartTimerRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *StartTimerRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type StartTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Stopped       *TimeEntry             `protobuf:"bytes,2,opt,name=stopped,proto3,oneof" json:"stopped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerResponse) Reset() {
+	*x = StartTimerResponse{}
+	mi := &file_todo_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerResponse) ProtoMessage() {}
+
+func (x *StartTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerResponse.ProtoReflect.Descriptor instead.
+func (*StartTimerResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *StartTimerResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *StartTimerResponse) GetStopped() *TimeEntry {
+	if x != nil {
+		return x.Stopped
+	}
+	return nil
+}
+
+type StopTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerRequest) Reset() {
+	*x = StopTimerRequest{}
+	mi := &file_todo_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerRequest) ProtoMessage() {}
+
+func (x *StopTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerRequest.ProtoReflect.Descriptor instead.
+func (*StopTimerRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{56}
+}
+
+type StopTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerResponse) Reset() {
+	*x = StopTimerResponse{}
+	mi := &file_todo_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerResponse) ProtoMessage() {}
+
+func (x *StopTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerResponse.ProtoReflect.Descriptor instead.
+func (*StopTimerResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StopTimerResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// a finished entry lasting at most a day
+type AddTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt     int64                  `protobuf:"varint,4,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTimeEntryRequest) Reset() {
+	*x = AddTimeEntryRequest{}
+	mi := &file_todo_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTimeEntryRequest) ProtoMessage() {}
+
+func (x *AddTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddTimeEntryRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddTimeEntryRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AddTimeEntryRequest) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AddTimeEntryRequest) GetStoppedAt() int64 {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return 0
+}
+
+type AddTimeEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTimeEntryResponse) Reset() {
+	*x = AddTimeEntryResponse{}
+	mi := &file_todo_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTimeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTimeEntryResponse) ProtoMessage() {}
+
+func (x *AddTimeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTimeEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddTimeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddTimeEntryResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// from and to take a date (YYYY-MM-DD) or an RFC 3339 time, the last 30 days by default
+type ListTimeEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // empty for all tasks
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // dates are read in it, the user's timezone if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeEntriesRequest) Reset() {
+	*x = ListTimeEntriesRequest{}
+	mi := &file_todo_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeEntriesRequest) ProtoMessage() {}
+
+func (x *ListTimeEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListTimeEntriesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListTimeEntriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListTimeEntriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListTimeEntriesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ListTimeEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimeEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeEntriesResponse) Reset() {
+	*x = ListTimeEntriesResponse{}
+	mi := &file_todo_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeEntriesResponse) ProtoMessage() {}
+
+func (x *ListTimeEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListTimeEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListTimeEntriesResponse) GetEntries() []*TimeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetTimeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // days are split in it, the user's timezone if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportRequest) Reset() {
+	*x = GetTimeReportRequest{}
+	mi := &file_todo_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportRequest) ProtoMessage() {}
+
+func (x *GetTimeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeReportRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTimeReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// tracked time of a task and project on a day
+type TimeReportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskTitle     string                 `protobuf:"bytes,3,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	Project       string                 `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	Seconds       int64                  `protobuf:"varint,5,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeReportRow) Reset() {
+	*x = TimeReportRow{}
+	mi := &file_todo_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeReportRow) ProtoMessage() {}
+
+func (x *TimeReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeReportRow.ProtoReflect.Descriptor instead.
+func (*TimeReportRow) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TimeReportRow) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *TimeReportRow) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TimeReportRow) GetTaskTitle() string {
+	if x != nil {
+		return x.TaskTitle
+	}
+	return ""
+}
+
+func (x *TimeReportRow) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *TimeReportRow) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type GetTimeReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*TimeReportRow       `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"` // by day, task title and project
+	TotalSeconds  int64                  `protobuf:"varint,2,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportResponse) Reset() {
+	*x = GetTimeReportResponse{}
+	mi := &file_todo_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportResponse) ProtoMessage() {}
+
+func (x *GetTimeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeReportResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetTimeReportResponse) GetRows() []*TimeReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetTimeReportResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+type Collaborator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          TaskRole               `protobuf:"varint,3,opt,name=role,proto3,enum=todo.TaskRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
+	mi := &file_todo_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collaborator) ProtoMessage() {}
+
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Collaborator) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Collaborator) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Collaborator) GetRole() TaskRole {
+	if x != nil {
+		return x.Role
+	}
+	return TaskRole_VIEWER
+}
+
+// shares the task or changes the user's role, only owners can share
+type ShareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          TaskRole               `protobuf:"varint,3,opt,name=role,proto3,enum=todo.TaskRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskRequest) Reset() {
+	*x = ShareTaskRequest{}
+	mi := &file_todo_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskRequest) ProtoMessage() {}
+
+func (x *ShareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskRequest.ProtoReflect.Descriptor instead.
+func (*ShareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ShareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetRole() TaskRole {
+	if x != nil {
+		return x.Role
+	}
+	return TaskRole_VIEWER
+}
+
+type ShareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborator  *Collaborator          `protobuf:"bytes,1,opt,name=collaborator,proto3" json:"collaborator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskResponse) Reset() {
+	*x = ShareTaskResponse{}
+	mi := &file_todo_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskResponse) ProtoMessage() {}
+
+func (x *ShareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskResponse.ProtoReflect.Descriptor instead.
+func (*ShareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ShareTaskResponse) GetCollaborator() *Collaborator {
+	if x != nil {
+		return x.Collaborator
+	}
+	return nil
+}
+
+// owners can remove anyone, other collaborators only themselves
+type UnshareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskRequest) Reset() {
+	*x = UnshareTaskRequest{}
+	mi := &file_todo_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskRequest) ProtoMessage() {}
+
+func (x *UnshareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnshareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UnshareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UnshareTaskRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnshareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskResponse) Reset() {
+	*x = UnshareTaskResponse{}
+	mi := &file_todo_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskResponse) ProtoMessage() {}
+
+func (x *UnshareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnshareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{69}
+}
+
+type ListCollaboratorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsRequest) Reset() {
+	*x = ListCollaboratorsRequest{}
+	mi := &file_todo_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsRequest) ProtoMessage() {}
+
+func (x *ListCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListCollaboratorsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListCollaboratorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborators []*Collaborator        `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"` // the owner first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsResponse) Reset() {
+	*x = ListCollaboratorsResponse{}
+	mi := &file_todo_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsResponse) ProtoMessage() {}
+
+func (x *ListCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListCollaboratorsResponse) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+// places the task between before_id, the task that ends up right before it, and after_id,
+// the one right after it, both of the same owner and workspace; either is empty for the start
+// or the end of the list. If status is set the task also moves to it, so a card can be
+// dragged into another column
+type MoveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BeforeId      string                 `protobuf:"bytes,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	AfterId       string                 `protobuf:"bytes,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Status        *TaskStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=todo.TaskStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_todo_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MoveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TODO
+}
+
+type MoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskResponse) Reset() {
+	*x = MoveTaskResponse{}
+	mi := &file_todo_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskResponse) ProtoMessage() {}
+
+func (x *MoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MoveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// undoes the caller's last task operation in the workspace: creating, updating, deleting,
+// bulk updating, importing, assigning or moving tasks. The last 50 operations of the last
+// 24 hours can be undone, an operation is refused if one of its tasks changed since
+type UndoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoRequest) Reset() {
+	*x = UndoRequest{}
+	mi := &file_todo_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRequest) ProtoMessage() {}
+
+func (x *UndoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
+func (*UndoRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{74}
+}
+
+type UndoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Operation      string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`                                   // create, update, delete, bulk_update, import, assign or move
+	Tasks          []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`                                           // the tasks as they are now
+	DeletedTaskIds []string               `protobuf:"bytes,3,rep,name=deleted_task_ids,json=deletedTaskIds,proto3" json:"deleted_task_ids,omitempty"` // tasks that were removed, e.g. by undoing their creation
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
+	mi := &file_todo_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoResponse) ProtoMessage() {}
+
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UndoResponse) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *UndoResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *UndoResponse) GetDeletedTaskIds() []string {
+	if x != nil {
+		return x.DeletedTaskIds
+	}
+	return nil
+}
+
+// redoes the operation the caller undid last, until a new operation is made
+type RedoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedoRequest) Reset() {
+	*x = RedoRequest{}
+	mi := &file_todo_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoRequest) ProtoMessage() {}
+
+func (x *RedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoRequest.ProtoReflect.Descriptor instead.
+func (*RedoRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{76}
+}
+
+type RedoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Operation      string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Tasks          []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	DeletedTaskIds []string               `protobuf:"bytes,3,rep,name=deleted_task_ids,json=deletedTaskIds,proto3" json:"deleted_task_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RedoResponse) Reset() {
+	*x = RedoResponse{}
+	mi := &file_todo_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoResponse) ProtoMessage() {}
+
+func (x *RedoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoResponse.ProtoReflect.Descriptor instead.
+func (*RedoResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RedoResponse) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RedoResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *RedoResponse) GetDeletedTaskIds() []string {
+	if x != nil {
+		return x.DeletedTaskIds
+	}
+	return nil
+}
+
+// title and description may hold {{name}} placeholders, {{date}} is the day the template
+// is instantiated on. due_offset is like "+3d": hours (h) make a timed due date,
+// days (d) and weeks (w) an all-day one. Empty for no due date.
+type TaskTemplateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      TaskPriority           `protobuf:"varint,3,opt,name=priority,proto3,enum=todo.TaskPriority" json:"priority,omitempty"`
+	DueOffset     string                 `protobuf:"bytes,4,opt,name=due_offset,json=dueOffset,proto3" json:"due_offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplateItem) Reset() {
+	*x = TaskTemplateItem{}
+	mi := &file_todo_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplateItem) ProtoMessage() {}
+
+func (x *TaskTemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplateItem.ProtoReflect.Descriptor instead.
+func (*TaskTemplateItem) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TaskTemplateItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskTemplateItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTemplateItem) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_LOW
+}
+
+func (x *TaskTemplateItem) GetDueOffset() string {
+	if x != nil {
+		return x.DueOffset
+	}
+	return ""
+}
+
+type TaskTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Task          *TaskTemplateItem      `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` // put on the task and every subtask
+	Subtasks      []*TaskTemplateItem    `protobuf:"bytes,5,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_todo_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TaskTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetTask() *TaskTemplateItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetSubtasks() []*TaskTemplateItem {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TaskTemplate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Task          *TaskTemplateItem      `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Subtasks      []*TaskTemplateItem    `protobuf:"bytes,4,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskTemplateRequest) Reset() {
+	*x = CreateTaskTemplateRequest{}
+	mi := &file_todo_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateTaskTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaskTemplateRequest) GetTask() *TaskTemplateItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CreateTaskTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskTemplateRequest) GetSubtasks() []*TaskTemplateItem {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type CreateTaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskTemplateResponse) Reset() {
+	*x = CreateTaskTemplateResponse{}
+	mi := &file_todo_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateTaskTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTaskTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskTemplatesRequest) Reset() {
+	*x = ListTaskTemplatesRequest{}
+	mi := &file_todo_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTaskTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{82}
+}
+
+type ListTaskTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TaskTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskTemplatesResponse) Reset() {
+	*x = ListTaskTemplatesResponse{}
+	mi := &file_todo_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTaskTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListTaskTemplatesResponse) GetTemplates() []*TaskTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTemplateRequest) Reset() {
+	*x = GetTaskTemplateRequest{}
+	mi := &file_todo_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTemplateRequest) ProtoMessage() {}
+
+func (x *GetTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetTaskTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTemplateResponse) Reset() {
+	*x = GetTaskTemplateResponse{}
+	mi := &file_todo_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTemplateResponse) ProtoMessage() {}
+
+func (x *GetTaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetTaskTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// replaces everything the template captures
+type UpdateTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Task          *TaskTemplateItem      `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Subtasks      []*TaskTemplateItem    `protobuf:"bytes,5,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskTemplateRequest) Reset() {
+	*x = UpdateTaskTemplateRequest{}
+	mi := &file_todo_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateTaskTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaskTemplateRequest) GetTask() *TaskTemplateItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTaskTemplateRequest) GetSubtasks() []*TaskTemplateItem {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type UpdateTaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskTemplateResponse) Reset() {
+	*x = UpdateTaskTemplateResponse{}
+	mi := &file_todo_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateTaskTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskTemplateRequest) Reset() {
+	*x = DeleteTaskTemplateRequest{}
+	mi := &file_todo_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteTaskTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskTemplateResponse) Reset() {
+	*x = DeleteTaskTemplateResponse{}
+	mi := &file_todo_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{89}
+}
+
+// creates the template's task and its subtasks in the active workspace at once,
+// every placeholder but {{date}} needs a variable
+type InstantiateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_todo_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // the task first, then its subtasks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_todo_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *InstantiateTemplateResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// assigns the task to a member of its workspace, an empty username unassigns it,
+// users who can edit the task can assign it
+type AssignTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTaskRequest) Reset() {
+	*x = AssignTaskRequest{}
+	mi := &file_todo_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTaskRequest) ProtoMessage() {}
+
+func (x *AssignTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTaskRequest.ProtoReflect.Descriptor instead.
+func (*AssignTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AssignTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AssignTaskRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AssignTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTaskResponse) Reset() {
+	*x = AssignTaskResponse{}
+	mi := &file_todo_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTaskResponse) ProtoMessage() {}
+
+func (x *AssignTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTaskResponse.ProtoReflect.Descriptor instead.
+func (*AssignTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AssignTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// tasks are scoped to the workspace chosen by the identity assertion,
+// the user's personal workspace (with the user's ID) if it has none
+type Workspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          WorkspaceRole          `protobuf:"varint,3,opt,name=role,proto3,enum=todo.WorkspaceRole" json:"role,omitempty"` // the caller's role
+	Personal      bool                   `protobuf:"varint,4,opt,name=personal,proto3" json:"personal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workspace) Reset() {
+	*x = Workspace{}
+	mi := &file_todo_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace) ProtoMessage() {}
+
+func (x *Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
+func (*Workspace) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Workspace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Workspace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Workspace) GetRole() WorkspaceRole {
+	if x != nil {
+		return x.Role
+	}
+	return WorkspaceRole_MEMBER
+}
+
+func (x *Workspace) GetPersonal() bool {
+	if x != nil {
+		return x.Personal
+	}
+	return false
+}
+
+type WorkspaceInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	WorkspaceName string                 `protobuf:"bytes,3,opt,name=workspace_name,json=workspaceName,proto3" json:"workspace_name,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"` // username, empty if the user was deleted
+	Role          WorkspaceRole          `protobuf:"varint,5,opt,name=role,proto3,enum=todo.WorkspaceRole" json:"role,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceInvitation) Reset() {
+	*x = WorkspaceInvitation{}
+	mi := &file_todo_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceInvitation) ProtoMessage() {}
+
+func (x *WorkspaceInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceInvitation.ProtoReflect.Descriptor instead.
+func (*WorkspaceInvitation) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *WorkspaceInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceInvitation) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *WorkspaceInvitation) GetWorkspaceName() string {
+	if x != nil {
+		return x.WorkspaceName
+	}
+	return ""
+}
+
+func (x *WorkspaceInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *WorkspaceInvitation) GetRole() WorkspaceRole {
+	if x != nil {
+		return x.Role
+	}
+	return WorkspaceRole_MEMBER
+}
+
+func (x *WorkspaceInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
+	mi := &file_todo_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
+	mi := &file_todo_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type ListWorkspacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesRequest) Reset() {
+	*x = ListWorkspacesRequest{}
+	mi := &file_todo_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesRequest) ProtoMessage() {}
+
+func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{98}
+}
+
+type ListWorkspacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspaces    []*Workspace           `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"` // the personal workspace first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesResponse) Reset() {
+	*x = ListWorkspacesResponse{}
+	mi := &file_todo_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesResponse) ProtoMessage() {}
+
+func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+// only admins and the owner can invite, as a member or an admin
+type InviteToWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          WorkspaceRole          `protobuf:"varint,3,opt,name=role,proto3,enum=todo.WorkspaceRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToWorkspaceRequest) Reset() {
+	*x = InviteToWorkspaceRequest{}
+	mi := &file_todo_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToWorkspaceRequest) ProtoMessage() {}
+
+func (x *InviteToWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*InviteToWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *InviteToWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *InviteToWorkspaceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteToWorkspaceRequest) GetRole() WorkspaceRole {
+	if x != nil {
+		return x.Role
+	}
+	return WorkspaceRole_MEMBER
+}
+
+type InviteToWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *WorkspaceInvitation   `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToWorkspaceResponse) Reset() {
+	*x = InviteToWorkspaceResponse{}
+	mi := &file_todo_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToWorkspaceResponse) ProtoMessage() {}
+
+func (x *InviteToWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*InviteToWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *InviteToWorkspaceResponse) GetInvitation() *WorkspaceInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListWorkspaceInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceInvitationsRequest) Reset() {
+	*x = ListWorkspaceInvitationsRequest{}
+	mi := &file_todo_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceInvitationsRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{102}
+}
+
+type ListWorkspaceInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*WorkspaceInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceInvitationsResponse) Reset() {
+	*x = ListWorkspaceInvitationsResponse{}
+	mi := &file_todo_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceInvitationsResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListWorkspaceInvitationsResponse) GetInvitations() []*WorkspaceInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RespondToWorkspaceInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToWorkspaceInvitationRequest) Reset() {
+	*x = RespondToWorkspaceInvitationRequest{}
+	mi := &file_todo_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToWorkspaceInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToWorkspaceInvitationRequest) ProtoMessage() {}
+
+func (x *RespondToWorkspaceInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToWorkspaceInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondToWorkspaceInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RespondToWorkspaceInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetDescription() string {
+func (x *RespondToWorkspaceInvitationRequest) GetAccept() bool {
 	if x != nil {
-		return x.Description
+		return x.Accept
 	}
-	return ""
+	return false
 }
 
-func (x *CreateTaskRequest) GetPriority() TaskPriority {
+type RespondToWorkspaceInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"` // set if the invitation was accepted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToWorkspaceInvitationResponse) Reset() {
+	*x = RespondToWorkspaceInvitationResponse{}
+	mi := &file_todo_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToWorkspaceInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToWorkspaceInvitationResponse) ProtoMessage() {}
+
+func (x *RespondToWorkspaceInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[105]
 	if x != nil {
-		return x.Priority
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TaskPriority_LOW
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTaskRequest) GetDueDate() int64 {
+// Deprecated: Use RespondToWorkspaceInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RespondToWorkspaceInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RespondToWorkspaceInvitationResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.DueDate
+		return x.Workspace
 	}
-	return 0
+	return nil
 }
 
-type CreateTaskResponse struct {
+type LeaveWorkspaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_todo_proto_msgTypes[9]
+func (x *LeaveWorkspaceRequest) Reset() {
+	*x = LeaveWorkspaceRequest{}
+	mi := &file_todo_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *LeaveWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*LeaveWorkspaceRequest) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[9]
+func (x *LeaveWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,40 +6463,39 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LeaveWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *LeaveWorkspaceRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.Task
+		return x.WorkspaceId
 	}
-	return nil
+	return ""
 }
 
-type GetTaskRequest struct {
+type LeaveWorkspaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_todo_proto_msgTypes[10]
+func (x *LeaveWorkspaceResponse) Reset() {
+	*x = LeaveWorkspaceResponse{}
+	mi := &file_todo_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *LeaveWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*LeaveWorkspaceResponse) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[10]
+func (x *LeaveWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,40 +6506,136 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LeaveWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetTaskRequest) GetId() string {
+// a login of the user on a device
+type Session struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId     string                 `protobuf:"bytes,3,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // empty for the personal workspace
+	Device          string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`                              // user agent
+	Ip              string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastRefreshedAt int64                  `protobuf:"varint,7,opt,name=last_refreshed_at,json=lastRefreshedAt,proto3" json:"last_refreshed_at,omitempty"` // when a refresh token was last exchanged, using access tokens doesn't update it
+	ExpiresAt       int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_todo_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Session) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetTaskResponse struct {
+func (x *Session) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Session) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetLastRefreshedAt() int64 {
+	if x != nil {
+		return x.LastRefreshedAt
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// starts a session of the user after logging in
+type CreateSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_todo_proto_msgTypes[11]
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_todo_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[11]
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,41 +6646,48 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetTaskResponse) GetTask() *Task {
+func (x *CreateSessionRequest) GetDevice() string {
 	if x != nil {
-		return x.Task
+		return x.Device
 	}
-	return nil
+	return ""
 }
 
-type Filters struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TaskStatuses   []TaskStatus           `protobuf:"varint,1,rep,packed,name=taskStatuses,proto3,enum=todo.TaskStatus" json:"taskStatuses,omitempty"`
-	TaskPriorities []TaskPriority         `protobuf:"varint,2,rep,packed,name=taskPriorities,proto3,enum=todo.TaskPriority" json:"taskPriorities,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CreateSessionRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-func (x *Filters) Reset() {
-	*x = Filters{}
-	mi := &file_todo_proto_msgTypes[12]
+type CreateSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
+	mi := &file_todo_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Filters) String() string {
+func (x *CreateSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filters) ProtoMessage() {}
+func (*CreateSessionResponse) ProtoMessage() {}
 
-func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[12]
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,48 +6698,50 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
-func (*Filters) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Filters) GetTaskStatuses() []TaskStatus {
+func (x *CreateSessionResponse) GetSession() *Session {
 	if x != nil {
-		return x.TaskStatuses
+		return x.Session
 	}
 	return nil
 }
 
-func (x *Filters) GetTaskPriorities() []TaskPriority {
+func (x *CreateSessionResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.TaskPriorities
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type OrderBy struct {
+// exchanges a refresh token for the next one, reusing an exchanged token revokes the session
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         SortField              `protobuf:"varint,1,opt,name=field,proto3,enum=todo.SortField" json:"field,omitempty"`
-	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=todo.SortDirection" json:"direction,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderBy) Reset() {
-	*x = OrderBy{}
-	mi := &file_todo_proto_msgTypes[13]
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_todo_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBy) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderBy) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *OrderBy) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[13]
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,51 +6752,150 @@ func (x *OrderBy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderBy.ProtoReflect.Descriptor instead.
-func (*OrderBy) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *OrderBy) GetField() SortField {
+func (x *RefreshSessionRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Field
+		return x.RefreshToken
 	}
-	return SortField_PRIORITY
+	return ""
 }
 
-func (x *OrderBy) GetDirection() SortDirection {
+func (x *RefreshSessionRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *RefreshSessionRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_todo_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RefreshSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *RefreshSessionResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_todo_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetSessionRequest) GetId() string {
 	if x != nil {
-		return x.Direction
+		return x.Id
 	}
-	return SortDirection_ASC
+	return ""
 }
 
-type GetTasksRequest struct {
+type GetSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int64                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber    int64                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	Filters       *Filters               `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
-	OrderBy       *OrderBy               `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
-	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksRequest) Reset() {
-	*x = GetTasksRequest{}
-	mi := &file_todo_proto_msgTypes[14]
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_todo_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksRequest) String() string {
+func (x *GetSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksRequest) ProtoMessage() {}
+func (*GetSessionResponse) ProtoMessage() {}
 
-func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[14]
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,70 +6906,76 @@ func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetTasksRequest) GetPageSize() int64 {
+func (x *GetSessionResponse) GetSession() *Session {
 	if x != nil {
-		return x.PageSize
+		return x.Session
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTasksRequest) GetPageNumber() int64 {
-	if x != nil {
-		return x.PageNumber
-	}
-	return 0
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksRequest) GetFilters() *Filters {
-	if x != nil {
-		return x.Filters
-	}
-	return nil
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_todo_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksRequest) GetOrderBy() *OrderBy {
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[115]
 	if x != nil {
-		return x.OrderBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetTasksRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{115}
 }
 
-type GetTasksResponse struct {
+type ListSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TotalPages    int64                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // last refreshed first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksResponse) Reset() {
-	*x = GetTasksResponse{}
-	mi := &file_todo_proto_msgTypes[15]
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_todo_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksResponse) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksResponse) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[15]
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,59 +6986,40 @@ func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksResponse) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetTasksResponse) GetTasks() []*Task {
+func (x *ListSessionsResponse) GetSessions() []*Session {
 	if x != nil {
-		return x.Tasks
+		return x.Sessions
 	}
 	return nil
 }
 
-func (x *GetTasksResponse) GetTotalCount() int64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetTasksResponse) GetTotalPages() int64 {
-	if x != nil {
-		return x.TotalPages
-	}
-	return 0
-}
-
-type UpdateTaskRequest struct {
+type RevokeSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Status        *TaskStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=todo.TaskStatus,oneof" json:"status,omitempty"`
-	Priority      *TaskPriority          `protobuf:"varint,5,opt,name=priority,proto3,enum=todo.TaskPriority,oneof" json:"priority,omitempty"`
-	DueDate       *int64                 `protobuf:"varint,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_todo_proto_msgTypes[16]
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_todo_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[16]
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,75 +7030,75 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UpdateTaskRequest) GetId() string {
+func (x *RevokeSessionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_todo_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) GetStatus() TaskStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return TaskStatus_TODO
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateTaskRequest) GetPriority() TaskPriority {
-	if x != nil && x.Priority != nil {
-		return *x.Priority
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TaskPriority_LOW
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTaskRequest) GetDueDate() int64 {
-	if x != nil && x.DueDate != nil {
-		return *x.DueDate
-	}
-	return 0
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{118}
 }
 
-type UpdateTaskResponse struct {
+type RevokeAllSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_todo_proto_msgTypes[17]
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_todo_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *RevokeAllSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[17]
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,40 +7109,70 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+type RevokeAllSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsResponse) Reset() {
+	*x = RevokeAllSessionsResponse{}
+	mi := &file_todo_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[120]
 	if x != nil {
-		return x.Task
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type DeleteTasksByIDRequest struct {
+// Deprecated: Use RevokeAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{120}
+}
+
+type SwitchSessionWorkspaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // empty for the personal workspace
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTasksByIDRequest) Reset() {
-	*x = DeleteTasksByIDRequest{}
-	mi := &file_todo_proto_msgTypes[18]
+func (x *SwitchSessionWorkspaceRequest) Reset() {
+	*x = SwitchSessionWorkspaceRequest{}
+	mi := &file_todo_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTasksByIDRequest) String() string {
+func (x *SwitchSessionWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTasksByIDRequest) ProtoMessage() {}
+func (*SwitchSessionWorkspaceRequest) ProtoMessage() {}
 
-func (x *DeleteTasksByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[18]
+func (x *SwitchSessionWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,39 +7183,47 @@ func (x *DeleteTasksByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTasksByIDRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTasksByIDRequest) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SwitchSessionWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*SwitchSessionWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DeleteTasksByIDRequest) GetIds() []string {
+func (x *SwitchSessionWorkspaceRequest) GetId() string {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteTasksByIDResponse struct {
+func (x *SwitchSessionWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type SwitchSessionWorkspaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTasksByIDResponse) Reset() {
-	*x = DeleteTasksByIDResponse{}
-	mi := &file_todo_proto_msgTypes[19]
+func (x *SwitchSessionWorkspaceResponse) Reset() {
+	*x = SwitchSessionWorkspaceResponse{}
+	mi := &file_todo_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTasksByIDResponse) String() string {
+func (x *SwitchSessionWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTasksByIDResponse) ProtoMessage() {}
+func (*SwitchSessionWorkspaceResponse) ProtoMessage() {}
 
-func (x *DeleteTasksByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_proto_msgTypes[19]
+func (x *SwitchSessionWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,9 +7234,16 @@ func (x *DeleteTasksByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTasksByIDResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTasksByIDResponse) Descriptor() ([]byte, []int) {
-	return file_todo_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SwitchSessionWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*SwitchSessionWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SwitchSessionWorkspaceResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
 }
 
 var File_todo_proto protoreflect.FileDescriptor
@@ -1292,11 +7251,10 @@ var File_todo_proto protoreflect.FileDescriptor
 const file_todo_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"todo.proto\x12\x04todo\"W\n" +
+	"todo.proto\x12\x04todo\"8\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12#\n" +
-	"\rpassword_hash\x18\x03 \x01(\tR\fpasswordHash\"K\n" +
+	"\busername\x18\x02 \x01(\tR\busernameJ\x04\b\x03\x10\x04\"K\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"4\n" +
@@ -1307,10 +7265,23 @@ const file_todo_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\";\n" +
 	"\x19GetUserByUsernameResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
+	".todo.UserR\x04user\"M\n" +
+	"\x13AuthenticateRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"6\n" +
+	"\x14AuthenticateResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
 	".todo.UserR\x04user\"'\n" +
 	"\x15DeleteUserByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16DeleteUserByIDResponse\"\xfb\x01\n" +
+	"\x16DeleteUserByIDResponse\"\x18\n" +
+	"\x16GetUserSettingsRequest\"5\n" +
+	"\x17GetUserSettingsResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\"7\n" +
+	"\x19UpdateUserSettingsRequest\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\"8\n" +
+	"\x1aUpdateUserSettingsResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\"\xc5\x05\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -1320,12 +7291,35 @@ const file_todo_proto_rawDesc = "" +
 	"\bpriority\x18\x06 \x01(\x0e2\x12.todo.TaskPriorityR\bpriority\x12\x19\n" +
 	"\bdue_date\x18\a \x01(\x03R\adueDate\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\b \x01(\x03R\tcreatedAt\"\x96\x01\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\aproject\x18\t \x01(\tR\aproject\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12!\n" +
+	"\fcompleted_at\x18\v \x01(\x03R\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\f \x01(\x03R\tstartedAt\x12=\n" +
+	"\x0fstatus_category\x18\r \x01(\x0e2\x14.todo.StatusCategoryR\x0estatusCategory\x12\x17\n" +
+	"\aall_day\x18\x0e \x01(\bR\x06allDay\x12\x18\n" +
+	"\aoverdue\x18\x0f \x01(\bR\aoverdue\x12'\n" +
+	"\x0ftracked_seconds\x18\x10 \x01(\x03R\x0etrackedSeconds\x12)\n" +
+	"\x10estimate_minutes\x18\x11 \x01(\rR\x0festimateMinutes\x12!\n" +
+	"\fstory_points\x18\x12 \x01(\rR\vstoryPoints\x12\x1f\n" +
+	"\vassignee_id\x18\x13 \x01(\tR\n" +
+	"assigneeId\x12\x12\n" +
+	"\x04rank\x18\x14 \x01(\tR\x04rank\x12\x1b\n" +
+	"\tparent_id\x18\x15 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04tags\x18\x16 \x03(\tR\x04tags\"\xab\x02\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
 	"\bpriority\x18\x03 \x01(\x0e2\x12.todo.TaskPriorityR\bpriority\x12\x19\n" +
-	"\bdue_date\x18\x04 \x01(\x03R\adueDate\"4\n" +
+	"\bdue_date\x18\x04 \x01(\x03R\adueDate\x12\x18\n" +
+	"\aproject\x18\x05 \x01(\tR\aproject\x12\x17\n" +
+	"\aall_day\x18\x06 \x01(\bR\x06allDay\x12)\n" +
+	"\x10estimate_minutes\x18\a \x01(\rR\x0festimateMinutes\x12!\n" +
+	"\fstory_points\x18\b \x01(\rR\vstoryPoints\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\"4\n" +
 	"\x12CreateTaskResponse\x12\x1e\n" +
 	"\x04task\x18\x01 \x01(\v2\n" +
 	".todo.TaskR\x04task\" \n" +
@@ -1333,20 +7327,42 @@ const file_todo_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x0fGetTaskResponse\x12\x1e\n" +
 	"\x04task\x18\x01 \x01(\v2\n" +
-	".todo.TaskR\x04task\"{\n" +
+	".todo.TaskR\x04task\"\xe2\x04\n" +
 	"\aFilters\x124\n" +
 	"\ftaskStatuses\x18\x01 \x03(\x0e2\x10.todo.TaskStatusR\ftaskStatuses\x12:\n" +
-	"\x0etaskPriorities\x18\x02 \x03(\x0e2\x12.todo.TaskPriorityR\x0etaskPriorities\"c\n" +
+	"\x0etaskPriorities\x18\x02 \x03(\x0e2\x12.todo.TaskPriorityR\x0etaskPriorities\x12\x1c\n" +
+	"\tdueBefore\x18\x03 \x01(\tR\tdueBefore\x12\x1a\n" +
+	"\bdueAfter\x18\x04 \x01(\tR\bdueAfter\x12$\n" +
+	"\rcreatedBefore\x18\x05 \x01(\tR\rcreatedBefore\x12\"\n" +
+	"\fcreatedAfter\x18\x06 \x01(\tR\fcreatedAfter\x12 \n" +
+	"\voverdueOnly\x18\a \x01(\bR\voverdueOnly\x12#\n" +
+	"\n" +
+	"hasDueDate\x18\b \x01(\bH\x00R\n" +
+	"hasDueDate\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12%\n" +
+	"\vhasEstimate\x18\n" +
+	" \x01(\bH\x01R\vhasEstimate\x88\x01\x01\x12.\n" +
+	"\x12maxEstimateMinutes\x18\v \x01(\rR\x12maxEstimateMinutes\x12 \n" +
+	"\vstoryPoints\x18\f \x03(\rR\vstoryPoints\x12\"\n" +
+	"\fsharedWithMe\x18\r \x01(\bR\fsharedWithMe\x12\"\n" +
+	"\fassignedToMe\x18\x0e \x01(\bR\fassignedToMe\x12\x1e\n" +
+	"\n" +
+	"assigneeId\x18\x0f \x01(\tR\n" +
+	"assigneeIdB\r\n" +
+	"\v_hasDueDateB\x0e\n" +
+	"\f_hasEstimate\"\x8b\x01\n" +
 	"\aOrderBy\x12%\n" +
 	"\x05field\x18\x01 \x01(\x0e2\x0f.todo.SortFieldR\x05field\x121\n" +
-	"\tdirection\x18\x02 \x01(\x0e2\x13.todo.SortDirectionR\tdirection\"\xea\x01\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x13.todo.SortDirectionR\tdirection\x12&\n" +
+	"\x05nulls\x18\x03 \x01(\x0e2\x10.todo.NullsOrderR\x05nulls\"\x96\x02\n" +
 	"\x0fGetTasksRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x03R\bpageSize\x12\x1f\n" +
 	"\vpage_number\x18\x02 \x01(\x03R\n" +
 	"pageNumber\x12,\n" +
 	"\afilters\x18\x03 \x01(\v2\r.todo.FiltersH\x00R\afilters\x88\x01\x01\x12-\n" +
 	"\border_by\x18\x04 \x01(\v2\r.todo.OrderByH\x01R\aorderBy\x88\x01\x01\x12\x19\n" +
-	"\x05title\x18\x05 \x01(\tH\x02R\x05title\x88\x01\x01B\n" +
+	"\x05title\x18\x05 \x01(\tH\x02R\x05title\x88\x01\x01\x12*\n" +
+	"\tsort_keys\x18\x06 \x03(\v2\r.todo.OrderByR\bsortKeysB\n" +
 	"\n" +
 	"\b_filtersB\v\n" +
 	"\t_order_byB\b\n" +
@@ -1357,25 +7373,371 @@ const file_todo_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
 	"totalCount\x12\x1f\n" +
 	"\vtotal_pages\x18\x03 \x01(\x03R\n" +
-	"totalPages\"\xa8\x02\n" +
+	"totalPages\"\xad\x04\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12-\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x10.todo.TaskStatusH\x02R\x06status\x88\x01\x01\x123\n" +
 	"\bpriority\x18\x05 \x01(\x0e2\x12.todo.TaskPriorityH\x03R\bpriority\x88\x01\x01\x12\x1e\n" +
-	"\bdue_date\x18\x06 \x01(\x03H\x04R\adueDate\x88\x01\x01B\b\n" +
+	"\bdue_date\x18\x06 \x01(\x03H\x04R\adueDate\x88\x01\x01\x12\x1d\n" +
+	"\aproject\x18\a \x01(\tH\x05R\aproject\x88\x01\x01\x12\x1c\n" +
+	"\aall_day\x18\b \x01(\bH\x06R\x06allDay\x88\x01\x01\x12.\n" +
+	"\x10estimate_minutes\x18\t \x01(\rH\aR\x0festimateMinutes\x88\x01\x01\x12&\n" +
+	"\fstory_points\x18\n" +
+	" \x01(\rH\bR\vstoryPoints\x88\x01\x01\x12'\n" +
+	"\x04tags\x18\v \x01(\v2\x0e.todo.TaskTagsH\tR\x04tags\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_statusB\v\n" +
 	"\t_priorityB\v\n" +
-	"\t_due_date\"4\n" +
+	"\t_due_dateB\n" +
+	"\n" +
+	"\b_projectB\n" +
+	"\n" +
+	"\b_all_dayB\x13\n" +
+	"\x11_estimate_minutesB\x0f\n" +
+	"\r_story_pointsB\a\n" +
+	"\x05_tags\"\x1e\n" +
+	"\bTaskTags\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"4\n" +
 	"\x12UpdateTaskResponse\x12\x1e\n" +
 	"\x04task\x18\x01 \x01(\v2\n" +
 	".todo.TaskR\x04task\"*\n" +
 	"\x16DeleteTasksByIDRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x19\n" +
-	"\x17DeleteTasksByIDResponse*1\n" +
+	"\x17DeleteTasksByIDResponse\"\xdf\x01\n" +
+	"\tTaskPatch\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x10.todo.TaskStatusH\x00R\x06status\x88\x01\x01\x123\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x12.todo.TaskPriorityH\x01R\bpriority\x88\x01\x01\x12\x1e\n" +
+	"\bdue_date\x18\x03 \x01(\x03H\x02R\adueDate\x88\x01\x01\x12\x1d\n" +
+	"\aproject\x18\x04 \x01(\tH\x03R\aproject\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_priorityB\v\n" +
+	"\t_due_dateB\n" +
+	"\n" +
+	"\b_project\"\x8b\x01\n" +
+	"\x16BulkUpdateTasksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12,\n" +
+	"\afilters\x18\x02 \x01(\v2\r.todo.FiltersH\x00R\afilters\x88\x01\x01\x12%\n" +
+	"\x05patch\x18\x03 \x01(\v2\x0f.todo.TaskPatchR\x05patchB\n" +
+	"\n" +
+	"\b_filters\"V\n" +
+	"\x14BulkUpdateTaskResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\bR\aupdated\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"t\n" +
+	"\x17BulkUpdateTasksResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.todo.BulkUpdateTaskResultR\aresults\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x03R\fupdatedCount\"\xd8\x01\n" +
+	"\rImportTaskRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x19\n" +
+	"\bdue_date\x18\x06 \x01(\tR\adueDate\x12\x18\n" +
+	"\aproject\x18\a \x01(\tR\aproject\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\"V\n" +
+	"\x12ImportTasksRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12'\n" +
+	"\x04rows\x18\x02 \x03(\v2\x13.todo.ImportTaskRowR\x04rows\"t\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xb7\x01\n" +
+	"\x13ImportTasksResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.todo.ImportRowResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x03R\fcreatedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x03R\x0eduplicateCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x03R\vfailedCount\"\x80\x01\n" +
+	"\x13GetTaskStatsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12)\n" +
+	"\x06bucket\x18\x03 \x01(\x0e2\x11.todo.StatsBucketR\x06bucket\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"M\n" +
+	"\vStatusCount\x12(\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x10.todo.TaskStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"U\n" +
+	"\rPriorityCount\x12.\n" +
+	"\bpriority\x18\x01 \x01(\x0e2\x12.todo.TaskPriorityR\bpriority\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"=\n" +
+	"\x0fCompletedBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x92\x04\n" +
+	"\x14GetTaskStatsResponse\x127\n" +
+	"\x0eopen_by_status\x18\x01 \x03(\v2\x11.todo.StatusCountR\fopenByStatus\x12=\n" +
+	"\x10open_by_priority\x18\x02 \x03(\v2\x13.todo.PriorityCountR\x0eopenByPriority\x12#\n" +
+	"\roverdue_count\x18\x03 \x01(\x03R\foverdueCount\x123\n" +
+	"\tcompleted\x18\x04 \x03(\v2\x15.todo.CompletedBucketR\tcompleted\x12'\n" +
+	"\x0fcompleted_count\x18\x05 \x01(\x03R\x0ecompletedCount\x124\n" +
+	"\x16avg_completion_seconds\x18\x06 \x01(\x03R\x14avgCompletionSeconds\x123\n" +
+	"\x16avg_cycle_time_seconds\x18\a \x01(\x03R\x13avgCycleTimeSeconds\x122\n" +
+	"\x15open_estimate_minutes\x18\b \x01(\x03R\x13openEstimateMinutes\x12*\n" +
+	"\x11open_story_points\x18\t \x01(\x03R\x0fopenStoryPoints\x124\n" +
+	"\x16completed_story_points\x18\n" +
+	" \x01(\x03R\x14completedStoryPoints\"\x1b\n" +
+	"\x19CreateCalendarFeedRequest\"2\n" +
+	"\x1aCreateCalendarFeedResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19RevokeCalendarFeedRequest\"\x1c\n" +
+	"\x1aRevokeCalendarFeedResponse\"2\n" +
+	"\x1aResolveCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"6\n" +
+	"\x1bResolveCalendarFeedResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"f\n" +
+	"\x0eWorkflowStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x14.todo.StatusCategoryR\bcategory\"8\n" +
+	"\x12WorkflowTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\rR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\rR\x02to\"\x97\x01\n" +
+	"\bWorkflow\x120\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x14.todo.WorkflowStatusR\bstatuses\x12:\n" +
+	"\vtransitions\x18\x02 \x03(\v2\x18.todo.WorkflowTransitionR\vtransitions\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bR\tisDefault\"\x14\n" +
+	"\x12GetWorkflowRequest\"A\n" +
+	"\x13GetWorkflowResponse\x12*\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x0e.todo.WorkflowR\bworkflow\"\x85\x01\n" +
+	"\x15UpdateWorkflowRequest\x120\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x14.todo.WorkflowStatusR\bstatuses\x12:\n" +
+	"\vtransitions\x18\x02 \x03(\v2\x18.todo.WorkflowTransitionR\vtransitions\"D\n" +
+	"\x16UpdateWorkflowResponse\x12*\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x0e.todo.WorkflowR\bworkflow\"\xa6\x01\n" +
+	"\tTimeEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"stopped_at\x18\x05 \x01(\x03R\tstoppedAt\x12\x18\n" +
+	"\aseconds\x18\x06 \x01(\x03R\aseconds\"F\n" +
+	"\x11StartTimerRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\"w\n" +
+	"\x12StartTimerResponse\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.todo.TimeEntryR\x05entry\x12.\n" +
+	"\astopped\x18\x02 \x01(\v2\x0f.todo.TimeEntryH\x00R\astopped\x88\x01\x01B\n" +
+	"\n" +
+	"\b_stopped\"\x12\n" +
+	"\x10StopTimerRequest\":\n" +
+	"\x11StopTimerResponse\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.todo.TimeEntryR\x05entry\"\x86\x01\n" +
+	"\x13AddTimeEntryRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\x03R\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"stopped_at\x18\x04 \x01(\x03R\tstoppedAt\"=\n" +
+	"\x14AddTimeEntryResponse\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.todo.TimeEntryR\x05entry\"q\n" +
+	"\x16ListTimeEntriesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"D\n" +
+	"\x17ListTimeEntriesResponse\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.todo.TimeEntryR\aentries\"V\n" +
+	"\x14GetTimeReportRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\x8d\x01\n" +
+	"\rTimeReportRow\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\x03 \x01(\tR\ttaskTitle\x12\x18\n" +
+	"\aproject\x18\x04 \x01(\tR\aproject\x12\x18\n" +
+	"\aseconds\x18\x05 \x01(\x03R\aseconds\"e\n" +
+	"\x15GetTimeReportResponse\x12'\n" +
+	"\x04rows\x18\x01 \x03(\v2\x13.todo.TimeReportRowR\x04rows\x12#\n" +
+	"\rtotal_seconds\x18\x02 \x01(\x03R\ftotalSeconds\"g\n" +
+	"\fCollaborator\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\"\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0e.todo.TaskRoleR\x04role\"k\n" +
+	"\x10ShareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\"\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0e.todo.TaskRoleR\x04role\"K\n" +
+	"\x11ShareTaskResponse\x126\n" +
+	"\fcollaborator\x18\x01 \x01(\v2\x12.todo.CollaboratorR\fcollaborator\"I\n" +
+	"\x12UnshareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x15\n" +
+	"\x13UnshareTaskResponse\"3\n" +
+	"\x18ListCollaboratorsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"U\n" +
+	"\x19ListCollaboratorsResponse\x128\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x12.todo.CollaboratorR\rcollaborators\"\x93\x01\n" +
+	"\x0fMoveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\tR\bbeforeId\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\tR\aafterId\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.todo.TaskStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"2\n" +
+	"\x10MoveTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".todo.TaskR\x04task\"\r\n" +
+	"\vUndoRequest\"x\n" +
+	"\fUndoResponse\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12 \n" +
+	"\x05tasks\x18\x02 \x03(\v2\n" +
+	".todo.TaskR\x05tasks\x12(\n" +
+	"\x10deleted_task_ids\x18\x03 \x03(\tR\x0edeletedTaskIds\"\r\n" +
+	"\vRedoRequest\"x\n" +
+	"\fRedoResponse\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12 \n" +
+	"\x05tasks\x18\x02 \x03(\v2\n" +
+	".todo.TaskR\x05tasks\x12(\n" +
+	"\x10deleted_task_ids\x18\x03 \x03(\tR\x0edeletedTaskIds\"\x99\x01\n" +
+	"\x10TaskTemplateItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x12.todo.TaskPriorityR\bpriority\x12\x1d\n" +
+	"\n" +
+	"due_offset\x18\x04 \x01(\tR\tdueOffset\"\xe4\x01\n" +
+	"\fTaskTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x04task\x18\x03 \x01(\v2\x16.todo.TaskTemplateItemR\x04task\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x122\n" +
+	"\bsubtasks\x18\x05 \x03(\v2\x16.todo.TaskTemplateItemR\bsubtasks\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\xa3\x01\n" +
+	"\x19CreateTaskTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x04task\x18\x02 \x01(\v2\x16.todo.TaskTemplateItemR\x04task\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x122\n" +
+	"\bsubtasks\x18\x04 \x03(\v2\x16.todo.TaskTemplateItemR\bsubtasks\"L\n" +
+	"\x1aCreateTaskTemplateResponse\x12.\n" +
+	"\btemplate\x18\x01 \x01(\v2\x12.todo.TaskTemplateR\btemplate\"\x1a\n" +
+	"\x18ListTaskTemplatesRequest\"M\n" +
+	"\x19ListTaskTemplatesResponse\x120\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x12.todo.TaskTemplateR\ttemplates\"(\n" +
+	"\x16GetTaskTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x17GetTaskTemplateResponse\x12.\n" +
+	"\btemplate\x18\x01 \x01(\v2\x12.todo.TaskTemplateR\btemplate\"\xb3\x01\n" +
+	"\x19UpdateTaskTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x04task\x18\x03 \x01(\v2\x16.todo.TaskTemplateItemR\x04task\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x122\n" +
+	"\bsubtasks\x18\x05 \x03(\v2\x16.todo.TaskTemplateItemR\bsubtasks\"L\n" +
+	"\x1aUpdateTaskTemplateResponse\x12.\n" +
+	"\btemplate\x18\x01 \x01(\v2\x12.todo.TaskTemplateR\btemplate\"+\n" +
+	"\x19DeleteTaskTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteTaskTemplateResponse\"\xca\x01\n" +
+	"\x1aInstantiateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12M\n" +
+	"\tvariables\x18\x02 \x03(\v2/.todo.InstantiateTemplateRequest.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
+	"\x1bInstantiateTemplateResponse\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".todo.TaskR\x05tasks\"H\n" +
+	"\x11AssignTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"4\n" +
+	"\x12AssignTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".todo.TaskR\x04task\"t\n" +
+	"\tWorkspace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.todo.WorkspaceRoleR\x04role\x12\x1a\n" +
+	"\bpersonal\x18\x04 \x01(\bR\bpersonal\"\xd6\x01\n" +
+	"\x13WorkspaceInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\x12%\n" +
+	"\x0eworkspace_name\x18\x03 \x01(\tR\rworkspaceName\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x04 \x01(\tR\tinvitedBy\x12'\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x13.todo.WorkspaceRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\",\n" +
+	"\x16CreateWorkspaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x17CreateWorkspaceResponse\x12-\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x0f.todo.WorkspaceR\tworkspace\"\x17\n" +
+	"\x15ListWorkspacesRequest\"I\n" +
+	"\x16ListWorkspacesResponse\x12/\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x0f.todo.WorkspaceR\n" +
+	"workspaces\"\x82\x01\n" +
+	"\x18InviteToWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.todo.WorkspaceRoleR\x04role\"V\n" +
+	"\x19InviteToWorkspaceResponse\x129\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x19.todo.WorkspaceInvitationR\n" +
+	"invitation\"!\n" +
+	"\x1fListWorkspaceInvitationsRequest\"_\n" +
+	" ListWorkspaceInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.todo.WorkspaceInvitationR\vinvitations\"b\n" +
+	"#RespondToWorkspaceInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"h\n" +
+	"$RespondToWorkspaceInvitationResponse\x122\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x0f.todo.WorkspaceH\x00R\tworkspace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_workspace\":\n" +
+	"\x15LeaveWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\"\x18\n" +
+	"\x16LeaveWorkspaceResponse\"\xe7\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x03 \x01(\tR\vworkspaceId\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12*\n" +
+	"\x11last_refreshed_at\x18\a \x01(\x03R\x0flastRefreshedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\">\n" +
+	"\x14CreateSessionRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\"e\n" +
+	"\x15CreateSessionResponse\x12'\n" +
+	"\asession\x18\x01 \x01(\v2\r.todo.SessionR\asession\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"d\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"f\n" +
+	"\x16RefreshSessionResponse\x12'\n" +
+	"\asession\x18\x01 \x01(\v2\r.todo.SessionR\asession\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"#\n" +
+	"\x11GetSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x12GetSessionResponse\x12'\n" +
+	"\asession\x18\x01 \x01(\v2\r.todo.SessionR\asession\"\x15\n" +
+	"\x13ListSessionsRequest\"A\n" +
+	"\x14ListSessionsResponse\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.todo.SessionR\bsessions\"&\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15RevokeSessionResponse\"\x1a\n" +
+	"\x18RevokeAllSessionsRequest\"\x1b\n" +
+	"\x19RevokeAllSessionsResponse\"R\n" +
+	"\x1dSwitchSessionWorkspaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\"I\n" +
+	"\x1eSwitchSessionWorkspaceResponse\x12'\n" +
+	"\asession\x18\x01 \x01(\v2\r.todo.SessionR\asession*1\n" +
 	"\n" +
 	"TaskStatus\x12\b\n" +
 	"\x04TODO\x10\x00\x12\x0f\n" +
@@ -1385,27 +7747,106 @@ const file_todo_proto_rawDesc = "" +
 	"\x03LOW\x10\x00\x12\n" +
 	"\n" +
 	"\x06MEDIUM\x10\x01\x12\b\n" +
-	"\x04HIGH\x10\x02*7\n" +
+	"\x04HIGH\x10\x02*\x9c\x01\n" +
 	"\tSortField\x12\f\n" +
 	"\bPRIORITY\x10\x00\x12\f\n" +
 	"\bDUE_DATE\x10\x01\x12\x0e\n" +
 	"\n" +
-	"CREATED_AT\x10\x02*\"\n" +
+	"CREATED_AT\x10\x02\x12\t\n" +
+	"\x05TITLE\x10\x03\x12\n" +
+	"\n" +
+	"\x06STATUS\x10\x04\x12\x0e\n" +
+	"\n" +
+	"UPDATED_AT\x10\x05\x12\x10\n" +
+	"\fCOMPLETED_AT\x10\x06\x12\f\n" +
+	"\bESTIMATE\x10\a\x12\x10\n" +
+	"\fSTORY_POINTS\x10\b\x12\n" +
+	"\n" +
+	"\x06MANUAL\x10\t*\"\n" +
 	"\rSortDirection\x12\a\n" +
 	"\x03ASC\x10\x00\x12\b\n" +
-	"\x04DESC\x10\x012\xba\x04\n" +
+	"\x04DESC\x10\x01*-\n" +
+	"\n" +
+	"NullsOrder\x12\x0e\n" +
+	"\n" +
+	"NULLS_LAST\x10\x00\x12\x0f\n" +
+	"\vNULLS_FIRST\x10\x01*.\n" +
+	"\vStatsBucket\x12\x0e\n" +
+	"\n" +
+	"BUCKET_DAY\x10\x00\x12\x0f\n" +
+	"\vBUCKET_WEEK\x10\x01*K\n" +
+	"\x0eStatusCategory\x12\x11\n" +
+	"\rCATEGORY_TODO\x10\x00\x12\x13\n" +
+	"\x0fCATEGORY_ACTIVE\x10\x01\x12\x11\n" +
+	"\rCATEGORY_DONE\x10\x02*-\n" +
+	"\bTaskRole\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x00\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x01\x12\t\n" +
+	"\x05OWNER\x10\x02*;\n" +
+	"\rWorkspaceRole\x12\n" +
+	"\n" +
+	"\x06MEMBER\x10\x00\x12\t\n" +
+	"\x05ADMIN\x10\x01\x12\x13\n" +
+	"\x0fWORKSPACE_OWNER\x10\x022\x8b\x1e\n" +
 	"\x0fDataBaseService\x12?\n" +
 	"\n" +
 	"CreateUser\x12\x17.todo.CreateUserRequest\x1a\x18.todo.CreateUserResponse\x12T\n" +
-	"\x11GetUserByUsername\x12\x1e.todo.GetUserByUsernameRequest\x1a\x1f.todo.GetUserByUsernameResponse\x12K\n" +
-	"\x0eDeleteUserByID\x12\x1b.todo.DeleteUserByIDRequest\x1a\x1c.todo.DeleteUserByIDResponse\x12?\n" +
+	"\x11GetUserByUsername\x12\x1e.todo.GetUserByUsernameRequest\x1a\x1f.todo.GetUserByUsernameResponse\x12E\n" +
+	"\fAuthenticate\x12\x19.todo.AuthenticateRequest\x1a\x1a.todo.AuthenticateResponse\x12K\n" +
+	"\x0eDeleteUserByID\x12\x1b.todo.DeleteUserByIDRequest\x1a\x1c.todo.DeleteUserByIDResponse\x12N\n" +
+	"\x0fGetUserSettings\x12\x1c.todo.GetUserSettingsRequest\x1a\x1d.todo.GetUserSettingsResponse\x12W\n" +
+	"\x12UpdateUserSettings\x12\x1f.todo.UpdateUserSettingsRequest\x1a .todo.UpdateUserSettingsResponse\x12?\n" +
 	"\n" +
 	"CreateTask\x12\x17.todo.CreateTaskRequest\x1a\x18.todo.CreateTaskResponse\x126\n" +
 	"\aGetTask\x12\x14.todo.GetTaskRequest\x1a\x15.todo.GetTaskResponse\x129\n" +
 	"\bGetTasks\x12\x15.todo.GetTasksRequest\x1a\x16.todo.GetTasksResponse\x12?\n" +
 	"\n" +
 	"UpdateTask\x12\x17.todo.UpdateTaskRequest\x1a\x18.todo.UpdateTaskResponse\x12N\n" +
-	"\x0fDeleteTasksByID\x12\x1c.todo.DeleteTasksByIDRequest\x1a\x1d.todo.DeleteTasksByIDResponseB$Z\"braunkc/todo-proto/gen/go;todoGRPCb\x06proto3"
+	"\x0fDeleteTasksByID\x12\x1c.todo.DeleteTasksByIDRequest\x1a\x1d.todo.DeleteTasksByIDResponse\x12N\n" +
+	"\x0fBulkUpdateTasks\x12\x1c.todo.BulkUpdateTasksRequest\x1a\x1d.todo.BulkUpdateTasksResponse\x12D\n" +
+	"\vImportTasks\x12\x18.todo.ImportTasksRequest\x1a\x19.todo.ImportTasksResponse(\x01\x12E\n" +
+	"\fGetTaskStats\x12\x19.todo.GetTaskStatsRequest\x1a\x1a.todo.GetTaskStatsResponse\x12B\n" +
+	"\vGetWorkflow\x12\x18.todo.GetWorkflowRequest\x1a\x19.todo.GetWorkflowResponse\x12K\n" +
+	"\x0eUpdateWorkflow\x12\x1b.todo.UpdateWorkflowRequest\x1a\x1c.todo.UpdateWorkflowResponse\x12?\n" +
+	"\n" +
+	"StartTimer\x12\x17.todo.StartTimerRequest\x1a\x18.todo.StartTimerResponse\x12<\n" +
+	"\tStopTimer\x12\x16.todo.StopTimerRequest\x1a\x17.todo.StopTimerResponse\x12E\n" +
+	"\fAddTimeEntry\x12\x19.todo.AddTimeEntryRequest\x1a\x1a.todo.AddTimeEntryResponse\x12N\n" +
+	"\x0fListTimeEntries\x12\x1c.todo.ListTimeEntriesRequest\x1a\x1d.todo.ListTimeEntriesResponse\x12H\n" +
+	"\rGetTimeReport\x12\x1a.todo.GetTimeReportRequest\x1a\x1b.todo.GetTimeReportResponse\x12<\n" +
+	"\tShareTask\x12\x16.todo.ShareTaskRequest\x1a\x17.todo.ShareTaskResponse\x12B\n" +
+	"\vUnshareTask\x12\x18.todo.UnshareTaskRequest\x1a\x19.todo.UnshareTaskResponse\x12T\n" +
+	"\x11ListCollaborators\x12\x1e.todo.ListCollaboratorsRequest\x1a\x1f.todo.ListCollaboratorsResponse\x12?\n" +
+	"\n" +
+	"AssignTask\x12\x17.todo.AssignTaskRequest\x1a\x18.todo.AssignTaskResponse\x129\n" +
+	"\bMoveTask\x12\x15.todo.MoveTaskRequest\x1a\x16.todo.MoveTaskResponse\x12-\n" +
+	"\x04Undo\x12\x11.todo.UndoRequest\x1a\x12.todo.UndoResponse\x12-\n" +
+	"\x04Redo\x12\x11.todo.RedoRequest\x1a\x12.todo.RedoResponse\x12W\n" +
+	"\x12CreateTaskTemplate\x12\x1f.todo.CreateTaskTemplateRequest\x1a .todo.CreateTaskTemplateResponse\x12T\n" +
+	"\x11ListTaskTemplates\x12\x1e.todo.ListTaskTemplatesRequest\x1a\x1f.todo.ListTaskTemplatesResponse\x12N\n" +
+	"\x0fGetTaskTemplate\x12\x1c.todo.GetTaskTemplateRequest\x1a\x1d.todo.GetTaskTemplateResponse\x12W\n" +
+	"\x12UpdateTaskTemplate\x12\x1f.todo.UpdateTaskTemplateRequest\x1a .todo.UpdateTaskTemplateResponse\x12W\n" +
+	"\x12DeleteTaskTemplate\x12\x1f.todo.DeleteTaskTemplateRequest\x1a .todo.DeleteTaskTemplateResponse\x12Z\n" +
+	"\x13InstantiateTemplate\x12 .todo.InstantiateTemplateRequest\x1a!.todo.InstantiateTemplateResponse\x12N\n" +
+	"\x0fCreateWorkspace\x12\x1c.todo.CreateWorkspaceRequest\x1a\x1d.todo.CreateWorkspaceResponse\x12K\n" +
+	"\x0eListWorkspaces\x12\x1b.todo.ListWorkspacesRequest\x1a\x1c.todo.ListWorkspacesResponse\x12T\n" +
+	"\x11InviteToWorkspace\x12\x1e.todo.InviteToWorkspaceRequest\x1a\x1f.todo.InviteToWorkspaceResponse\x12i\n" +
+	"\x18ListWorkspaceInvitations\x12%.todo.ListWorkspaceInvitationsRequest\x1a&.todo.ListWorkspaceInvitationsResponse\x12u\n" +
+	"\x1cRespondToWorkspaceInvitation\x12).todo.RespondToWorkspaceInvitationRequest\x1a*.todo.RespondToWorkspaceInvitationResponse\x12K\n" +
+	"\x0eLeaveWorkspace\x12\x1b.todo.LeaveWorkspaceRequest\x1a\x1c.todo.LeaveWorkspaceResponse\x12W\n" +
+	"\x12CreateCalendarFeed\x12\x1f.todo.CreateCalendarFeedRequest\x1a .todo.CreateCalendarFeedResponse\x12W\n" +
+	"\x12RevokeCalendarFeed\x12\x1f.todo.RevokeCalendarFeedRequest\x1a .todo.RevokeCalendarFeedResponse\x12Z\n" +
+	"\x13ResolveCalendarFeed\x12 .todo.ResolveCalendarFeedRequest\x1a!.todo.ResolveCalendarFeedResponse\x12H\n" +
+	"\rCreateSession\x12\x1a.todo.CreateSessionRequest\x1a\x1b.todo.CreateSessionResponse\x12K\n" +
+	"\x0eRefreshSession\x12\x1b.todo.RefreshSessionRequest\x1a\x1c.todo.RefreshSessionResponse\x12?\n" +
+	"\n" +
+	"GetSession\x12\x17.todo.GetSessionRequest\x1a\x18.todo.GetSessionResponse\x12E\n" +
+	"\fListSessions\x12\x19.todo.ListSessionsRequest\x1a\x1a.todo.ListSessionsResponse\x12H\n" +
+	"\rRevokeSession\x12\x1a.todo.RevokeSessionRequest\x1a\x1b.todo.RevokeSessionResponse\x12T\n" +
+	"\x11RevokeAllSessions\x12\x1e.todo.RevokeAllSessionsRequest\x1a\x1f.todo.RevokeAllSessionsResponse\x12c\n" +
+	"\x16SwitchSessionWorkspace\x12#.todo.SwitchSessionWorkspaceRequest\x1a$.todo.SwitchSessionWorkspaceResponseB$Z\"braunkc/todo-proto/gen/go;todoGRPCb\x06proto3"
 
 var (
 	file_todo_proto_rawDescOnce sync.Once
@@ -1419,73 +7860,332 @@ func file_todo_proto_rawDescGZIP() []byte {
 	return file_todo_proto_rawDescData
 }
 
-var file_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_todo_proto_goTypes = []any{
-	(TaskStatus)(0),                   // 0: todo.TaskStatus
-	(TaskPriority)(0),                 // 1: todo.TaskPriority
-	(SortField)(0),                    // 2: todo.SortField
-	(SortDirection)(0),                // 3: todo.SortDirection
-	(*User)(nil),                      // 4: todo.User
-	(*CreateUserRequest)(nil),         // 5: todo.CreateUserRequest
-	(*CreateUserResponse)(nil),        // 6: todo.CreateUserResponse
-	(*GetUserByUsernameRequest)(nil),  // 7: todo.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil), // 8: todo.GetUserByUsernameResponse
-	(*DeleteUserByIDRequest)(nil),     // 9: todo.DeleteUserByIDRequest
-	(*DeleteUserByIDResponse)(nil),    // 10: todo.DeleteUserByIDResponse
-	(*Task)(nil),                      // 11: todo.Task
-	(*CreateTaskRequest)(nil),         // 12: todo.CreateTaskRequest
-	(*CreateTaskResponse)(nil),        // 13: todo.CreateTaskResponse
-	(*GetTaskRequest)(nil),            // 14: todo.GetTaskRequest
-	(*GetTaskResponse)(nil),           // 15: todo.GetTaskResponse
-	(*Filters)(nil),                   // 16: todo.Filters
-	(*OrderBy)(nil),                   // 17: todo.OrderBy
-	(*GetTasksRequest)(nil),           // 18: todo.GetTasksRequest
-	(*GetTasksResponse)(nil),          // 19: todo.GetTasksResponse
-	(*UpdateTaskRequest)(nil),         // 20: todo.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),        // 21: todo.UpdateTaskResponse
-	(*DeleteTasksByIDRequest)(nil),    // 22: todo.DeleteTasksByIDRequest
-	(*DeleteTasksByIDResponse)(nil),   // 23: todo.DeleteTasksByIDResponse
+	(TaskStatus)(0),                              // 0: todo.TaskStatus
+	(TaskPriority)(0),                            // 1: todo.TaskPriority
+	(SortField)(0),                               // 2: todo.SortField
+	(SortDirection)(0),                           // 3: todo.SortDirection
+	(NullsOrder)(0),                              // 4: todo.NullsOrder
+	(StatsBucket)(0),                             // 5: todo.StatsBucket
+	(StatusCategory)(0),                          // 6: todo.StatusCategory
+	(TaskRole)(0),                                // 7: todo.TaskRole
+	(WorkspaceRole)(0),                           // 8: todo.WorkspaceRole
+	(*User)(nil),                                 // 9: todo.User
+	(*CreateUserRequest)(nil),                    // 10: todo.CreateUserRequest
+	(*CreateUserResponse)(nil),                   // 11: todo.CreateUserResponse
+	(*GetUserByUsernameRequest)(nil),             // 12: todo.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil),            // 13: todo.GetUserByUsernameResponse
+	(*AuthenticateRequest)(nil),                  // 14: todo.AuthenticateRequest
+	(*AuthenticateResponse)(nil),                 // 15: todo.AuthenticateResponse
+	(*DeleteUserByIDRequest)(nil),                // 16: todo.DeleteUserByIDRequest
+	(*DeleteUserByIDResponse)(nil),               // 17: todo.DeleteUserByIDResponse
+	(*GetUserSettingsRequest)(nil),               // 18: todo.GetUserSettingsRequest
+	(*GetUserSettingsResponse)(nil),              // 19: todo.GetUserSettingsResponse
+	(*UpdateUserSettingsRequest)(nil),            // 20: todo.UpdateUserSettingsRequest
+	(*UpdateUserSettingsResponse)(nil),           // 21: todo.UpdateUserSettingsResponse
+	(*Task)(nil),                                 // 22: todo.Task
+	(*CreateTaskRequest)(nil),                    // 23: todo.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                   // 24: todo.CreateTaskResponse
+	(*GetTaskRequest)(nil),                       // 25: todo.GetTaskRequest
+	(*GetTaskResponse)(nil),                      // 26: todo.GetTaskResponse
+	(*Filters)(nil),                              // 27: todo.Filters
+	(*OrderBy)(nil),                              // 28: todo.OrderBy
+	(*GetTasksRequest)(nil),                      // 29: todo.GetTasksRequest
+	(*GetTasksResponse)(nil),                     // 30: todo.GetTasksResponse
+	(*UpdateTaskRequest)(nil),                    // 31: todo.UpdateTaskRequest
+	(*TaskTags)(nil),                             // 32: todo.TaskTags
+	(*UpdateTaskResponse)(nil),                   // 33: todo.UpdateTaskResponse
+	(*DeleteTasksByIDRequest)(nil),               // 34: todo.DeleteTasksByIDRequest
+	(*DeleteTasksByIDResponse)(nil),              // 35: todo.DeleteTasksByIDResponse
+	(*TaskPatch)(nil),                            // 36: todo.TaskPatch
+	(*BulkUpdateTasksRequest)(nil),               // 37: todo.BulkUpdateTasksRequest
+	(*BulkUpdateTaskResult)(nil),                 // 38: todo.BulkUpdateTaskResult
+	(*BulkUpdateTasksResponse)(nil),              // 39: todo.BulkUpdateTasksResponse
+	(*ImportTaskRow)(nil),                        // 40: todo.ImportTaskRow
+	(*ImportTasksRequest)(nil),                   // 41: todo.ImportTasksRequest
+	(*ImportRowResult)(nil),                      // 42: todo.ImportRowResult
+	(*ImportTasksResponse)(nil),                  // 43: todo.ImportTasksResponse
+	(*GetTaskStatsRequest)(nil),                  // 44: todo.GetTaskStatsRequest
+	(*StatusCount)(nil),                          // 45: todo.StatusCount
+	(*PriorityCount)(nil),                        // 46: todo.PriorityCount
+	(*CompletedBucket)(nil),                      // 47: todo.CompletedBucket
+	(*GetTaskStatsResponse)(nil),                 // 48: todo.GetTaskStatsResponse
+	(*CreateCalendarFeedRequest)(nil),            // 49: todo.CreateCalendarFeedRequest
+	(*CreateCalendarFeedResponse)(nil),           // 50: todo.CreateCalendarFeedResponse
+	(*RevokeCalendarFeedRequest)(nil),            // 51: todo.RevokeCalendarFeedRequest
+	(*RevokeCalendarFeedResponse)(nil),           // 52: todo.RevokeCalendarFeedResponse
+	(*ResolveCalendarFeedRequest)(nil),           // 53: todo.ResolveCalendarFeedRequest
+	(*ResolveCalendarFeedResponse)(nil),          // 54: todo.ResolveCalendarFeedResponse
+	(*WorkflowStatus)(nil),                       // 55: todo.WorkflowStatus
+	(*WorkflowTransition)(nil),                   // 56: todo.WorkflowTransition
+	(*Workflow)(nil),                             // 57: todo.Workflow
+	(*GetWorkflowRequest)(nil),                   // 58: todo.GetWorkflowRequest
+	(*GetWorkflowResponse)(nil),                  // 59: todo.GetWorkflowResponse
+	(*UpdateWorkflowRequest)(nil),                // 60: todo.UpdateWorkflowRequest
+	(*UpdateWorkflowResponse)(nil),               // 61: todo.UpdateWorkflowResponse
+	(*TimeEntry)(nil),                            // 62: todo.TimeEntry
+	(*StartTimerRequest)(nil),                    // 63: todo.StartTimerRequest
+	(*StartTimerResponse)(nil),                   // 64: todo.StartTimerResponse
+	(*StopTimerRequest)(nil),                     // 65: todo.StopTimerRequest
+	(*StopTimerResponse)(nil),                    // 66: todo.StopTimerResponse
+	(*AddTimeEntryRequest)(nil),                  // 67: todo.AddTimeEntryRequest
+	(*AddTimeEntryResponse)(nil),                 // 68: todo.AddTimeEntryResponse
+	(*ListTimeEntriesRequest)(nil),               // 69: todo.ListTimeEntriesRequest
+	(*ListTimeEntriesResponse)(nil),              // 70: todo.ListTimeEntriesResponse
+	(*GetTimeReportRequest)(nil),                 // 71: todo.GetTimeReportRequest
+	(*TimeReportRow)(nil),                        // 72: todo.TimeReportRow
+	(*GetTimeReportResponse)(nil),                // 73: todo.GetTimeReportResponse
+	(*Collaborator)(nil),                         // 74: todo.Collaborator
+	(*ShareTaskRequest)(nil),                     // 75: todo.ShareTaskRequest
+	(*ShareTaskResponse)(nil),                    // 76: todo.ShareTaskResponse
+	(*UnshareTaskRequest)(nil),                   // 77: todo.UnshareTaskRequest
+	(*UnshareTaskResponse)(nil),                  // 78: todo.UnshareTaskResponse
+	(*ListCollaboratorsRequest)(nil),             // 79: todo.ListCollaboratorsRequest
+	(*ListCollaboratorsResponse)(nil),            // 80: todo.ListCollaboratorsResponse
+	(*MoveTaskRequest)(nil),                      // 81: todo.MoveTaskRequest
+	(*MoveTaskResponse)(nil),                     // 82: todo.MoveTaskResponse
+	(*UndoRequest)(nil),                          // 83: todo.UndoRequest
+	(*UndoResponse)(nil),                         // 84: todo.UndoResponse
+	(*RedoRequest)(nil),                          // 85: todo.RedoRequest
+	(*RedoResponse)(nil),                         // 86: todo.RedoResponse
+	(*TaskTemplateItem)(nil),                     // 87: todo.TaskTemplateItem
+	(*TaskTemplate)(nil),                         // 88: todo.TaskTemplate
+	(*CreateTaskTemplateRequest)(nil),            // 89: todo.CreateTaskTemplateRequest
+	(*CreateTaskTemplateResponse)(nil),           // 90: todo.CreateTaskTemplateResponse
+	(*ListTaskTemplatesRequest)(nil),             // 91: todo.ListTaskTemplatesRequest
+	(*ListTaskTemplatesResponse)(nil),            // 92: todo.ListTaskTemplatesResponse
+	(*GetTaskTemplateRequest)(nil),               // 93: todo.GetTaskTemplateRequest
+	(*GetTaskTemplateResponse)(nil),              // 94: todo.GetTaskTemplateResponse
+	(*UpdateTaskTemplateRequest)(nil),            // 95: todo.UpdateTaskTemplateRequest
+	(*UpdateTaskTemplateResponse)(nil),           // 96: todo.UpdateTaskTemplateResponse
+	(*DeleteTaskTemplateRequest)(nil),            // 97: todo.DeleteTaskTemplateRequest
+	(*DeleteTaskTemplateResponse)(nil),           // 98: todo.DeleteTaskTemplateResponse
+	(*InstantiateTemplateRequest)(nil),           // 99: todo.InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil),          // 100: todo.InstantiateTemplateResponse
+	(*AssignTaskRequest)(nil),                    // 101: todo.AssignTaskRequest
+	(*AssignTaskResponse)(nil),                   // 102: todo.AssignTaskResponse
+	(*Workspace)(nil),                            // 103: todo.Workspace
+	(*WorkspaceInvitation)(nil),                  // 104: todo.WorkspaceInvitation
+	(*CreateWorkspaceRequest)(nil),               // 105: todo.CreateWorkspaceRequest
+	(*CreateWorkspaceResponse)(nil),              // 106: todo.CreateWorkspaceResponse
+	(*ListWorkspacesRequest)(nil),                // 107: todo.ListWorkspacesRequest
+	(*ListWorkspacesResponse)(nil),               // 108: todo.ListWorkspacesResponse
+	(*InviteToWorkspaceRequest)(nil),             // 109: todo.InviteToWorkspaceRequest
+	(*InviteToWorkspaceResponse)(nil),            // 110: todo.InviteToWorkspaceResponse
+	(*ListWorkspaceInvitationsRequest)(nil),      // 111: todo.ListWorkspaceInvitationsRequest
+	(*ListWorkspaceInvitationsResponse)(nil),     // 112: todo.ListWorkspaceInvitationsResponse
+	(*RespondToWorkspaceInvitationRequest)(nil),  // 113: todo.RespondToWorkspaceInvitationRequest
+	(*RespondToWorkspaceInvitationResponse)(nil), // 114: todo.RespondToWorkspaceInvitationResponse
+	(*LeaveWorkspaceRequest)(nil),                // 115: todo.LeaveWorkspaceRequest
+	(*LeaveWorkspaceResponse)(nil),               // 116: todo.LeaveWorkspaceResponse
+	(*Session)(nil),                              // 117: todo.Session
+	(*CreateSessionRequest)(nil),                 // 118: todo.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                // 119: todo.CreateSessionResponse
+	(*RefreshSessionRequest)(nil),                // 120: todo.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),               // 121: todo.RefreshSessionResponse
+	(*GetSessionRequest)(nil),                    // 122: todo.GetSessionRequest
+	(*GetSessionResponse)(nil),                   // 123: todo.GetSessionResponse
+	(*ListSessionsRequest)(nil),                  // 124: todo.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                 // 125: todo.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                 // 126: todo.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),                // 127: todo.RevokeSessionResponse
+	(*RevokeAllSessionsRequest)(nil),             // 128: todo.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),            // 129: todo.RevokeAllSessionsResponse
+	(*SwitchSessionWorkspaceRequest)(nil),        // 130: todo.SwitchSessionWorkspaceRequest
+	(*SwitchSessionWorkspaceResponse)(nil),       // 131: todo.SwitchSessionWorkspaceResponse
+	nil,                                          // 132: todo.InstantiateTemplateRequest.VariablesEntry
 }
 var file_todo_proto_depIdxs = []int32{
-	4,  // 0: todo.CreateUserResponse.user:type_name -> todo.User
-	4,  // 1: todo.GetUserByUsernameResponse.user:type_name -> todo.User
-	0,  // 2: todo.Task.status:type_name -> todo.TaskStatus
-	1,  // 3: todo.Task.priority:type_name -> todo.TaskPriority
-	1,  // 4: todo.CreateTaskRequest.priority:type_name -> todo.TaskPriority
-	11, // 5: todo.CreateTaskResponse.task:type_name -> todo.Task
-	11, // 6: todo.GetTaskResponse.task:type_name -> todo.Task
-	0,  // 7: todo.Filters.taskStatuses:type_name -> todo.TaskStatus
-	1,  // 8: todo.Filters.taskPriorities:type_name -> todo.TaskPriority
-	2,  // 9: todo.OrderBy.field:type_name -> todo.SortField
-	3,  // 10: todo.OrderBy.direction:type_name -> todo.SortDirection
-	16, // 11: todo.GetTasksRequest.filters:type_name -> todo.Filters
-	17, // 12: todo.GetTasksRequest.order_by:type_name -> todo.OrderBy
-	11, // 13: todo.GetTasksResponse.tasks:type_name -> todo.Task
-	0,  // 14: todo.UpdateTaskRequest.status:type_name -> todo.TaskStatus
-	1,  // 15: todo.UpdateTaskRequest.priority:type_name -> todo.TaskPriority
-	11, // 16: todo.UpdateTaskResponse.task:type_name -> todo.Task
-	5,  // 17: todo.DataBaseService.CreateUser:input_type -> todo.CreateUserRequest
-	7,  // 18: todo.DataBaseService.GetUserByUsername:input_type -> todo.GetUserByUsernameRequest
-	9,  // 19: todo.DataBaseService.DeleteUserByID:input_type -> todo.DeleteUserByIDRequest
-	12, // 20: todo.DataBaseService.CreateTask:input_type -> todo.CreateTaskRequest
-	14, // 21: todo.DataBaseService.GetTask:input_type -> todo.GetTaskRequest
-	18, // 22: todo.DataBaseService.GetTasks:input_type -> todo.GetTasksRequest
-	20, // 23: todo.DataBaseService.UpdateTask:input_type -> todo.UpdateTaskRequest
-	22, // 24: todo.DataBaseService.DeleteTasksByID:input_type -> todo.DeleteTasksByIDRequest
-	6,  // 25: todo.DataBaseService.CreateUser:output_type -> todo.CreateUserResponse
-	8,  // 26: todo.DataBaseService.GetUserByUsername:output_type -> todo.GetUserByUsernameResponse
-	10, // 27: todo.DataBaseService.DeleteUserByID:output_type -> todo.DeleteUserByIDResponse
-	13, // 28: todo.DataBaseService.CreateTask:output_type -> todo.CreateTaskResponse
-	15, // 29: todo.DataBaseService.GetTask:output_type -> todo.GetTaskResponse
-	19, // 30: todo.DataBaseService.GetTasks:output_type -> todo.GetTasksResponse
-	21, // 31: todo.DataBaseService.UpdateTask:output_type -> todo.UpdateTaskResponse
-	23, // 32: todo.DataBaseService.DeleteTasksByID:output_type -> todo.DeleteTasksByIDResponse
-	25, // [25:33] is the sub-list for method output_type
-	17, // [17:25] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	9,   // 0: todo.CreateUserResponse.user:type_name -> todo.User
+	9,   // 1: todo.GetUserByUsernameResponse.user:type_name -> todo.User
+	9,   // 2: todo.AuthenticateResponse.user:type_name -> todo.User
+	0,   // 3: todo.Task.status:type_name -> todo.TaskStatus
+	1,   // 4: todo.Task.priority:type_name -> todo.TaskPriority
+	6,   // 5: todo.Task.status_category:type_name -> todo.StatusCategory
+	1,   // 6: todo.CreateTaskRequest.priority:type_name -> todo.TaskPriority
+	22,  // 7: todo.CreateTaskResponse.task:type_name -> todo.Task
+	22,  // 8: todo.GetTaskResponse.task:type_name -> todo.Task
+	0,   // 9: todo.Filters.taskStatuses:type_name -> todo.TaskStatus
+	1,   // 10: todo.Filters.taskPriorities:type_name -> todo.TaskPriority
+	2,   // 11: todo.OrderBy.field:type_name -> todo.SortField
+	3,   // 12: todo.OrderBy.direction:type_name -> todo.SortDirection
+	4,   // 13: todo.OrderBy.nulls:type_name -> todo.NullsOrder
+	27,  // 14: todo.GetTasksRequest.filters:type_name -> todo.Filters
+	28,  // 15: todo.GetTasksRequest.order_by:type_name -> todo.OrderBy
+	28,  // 16: todo.GetTasksRequest.sort_keys:type_name -> todo.OrderBy
+	22,  // 17: todo.GetTasksResponse.tasks:type_name -> todo.Task
+	0,   // 18: todo.UpdateTaskRequest.status:type_name -> todo.TaskStatus
+	1,   // 19: todo.UpdateTaskRequest.priority:type_name -> todo.TaskPriority
+	32,  // 20: todo.UpdateTaskRequest.tags:type_name -> todo.TaskTags
+	22,  // 21: todo.UpdateTaskResponse.task:type_name -> todo.Task
+	0,   // 22: todo.TaskPatch.status:type_name -> todo.TaskStatus
+	1,   // 23: todo.TaskPatch.priority:type_name -> todo.TaskPriority
+	27,  // 24: todo.BulkUpdateTasksRequest.filters:type_name -> todo.Filters
+	36,  // 25: todo.BulkUpdateTasksRequest.patch:type_name -> todo.TaskPatch
+	38,  // 26: todo.BulkUpdateTasksResponse.results:type_name -> todo.BulkUpdateTaskResult
+	40,  // 27: todo.ImportTasksRequest.rows:type_name -> todo.ImportTaskRow
+	42,  // 28: todo.ImportTasksResponse.results:type_name -> todo.ImportRowResult
+	5,   // 29: todo.GetTaskStatsRequest.bucket:type_name -> todo.StatsBucket
+	0,   // 30: todo.StatusCount.status:type_name -> todo.TaskStatus
+	1,   // 31: todo.PriorityCount.priority:type_name -> todo.TaskPriority
+	45,  // 32: todo.GetTaskStatsResponse.open_by_status:type_name -> todo.StatusCount
+	46,  // 33: todo.GetTaskStatsResponse.open_by_priority:type_name -> todo.PriorityCount
+	47,  // 34: todo.GetTaskStatsResponse.completed:type_name -> todo.CompletedBucket
+	6,   // 35: todo.WorkflowStatus.category:type_name -> todo.StatusCategory
+	55,  // 36: todo.Workflow.statuses:type_name -> todo.WorkflowStatus
+	56,  // 37: todo.Workflow.transitions:type_name -> todo.WorkflowTransition
+	57,  // 38: todo.GetWorkflowResponse.workflow:type_name -> todo.Workflow
+	55,  // 39: todo.UpdateWorkflowRequest.statuses:type_name -> todo.WorkflowStatus
+	56,  // 40: todo.UpdateWorkflowRequest.transitions:type_name -> todo.WorkflowTransition
+	57,  // 41: todo.UpdateWorkflowResponse.workflow:type_name -> todo.Workflow
+	62,  // 42: todo.StartTimerResponse.entry:type_name -> todo.TimeEntry
+	62,  // 43: todo.StartTimerResponse.stopped:type_name -> todo.TimeEntry
+	62,  // 44: todo.StopTimerResponse.entry:type_name -> todo.TimeEntry
+	62,  // 45: todo.AddTimeEntryResponse.entry:type_name -> todo.TimeEntry
+	62,  // 46: todo.ListTimeEntriesResponse.entries:type_name -> todo.TimeEntry
+	72,  // 47: todo.GetTimeReportResponse.rows:type_name -> todo.TimeReportRow
+	7,   // 48: todo.Collaborator.role:type_name -> todo.TaskRole
+	7,   // 49: todo.ShareTaskRequest.role:type_name -> todo.TaskRole
+	74,  // 50: todo.ShareTaskResponse.collaborator:type_name -> todo.Collaborator
+	74,  // 51: todo.ListCollaboratorsResponse.collaborators:type_name -> todo.Collaborator
+	0,   // 52: todo.MoveTaskRequest.status:type_name -> todo.TaskStatus
+	22,  // 53: todo.MoveTaskResponse.task:type_name -> todo.Task
+	22,  // 54: todo.UndoResponse.tasks:type_name -> todo.Task
+	22,  // 55: todo.RedoResponse.tasks:type_name -> todo.Task
+	1,   // 56: todo.TaskTemplateItem.priority:type_name -> todo.TaskPriority
+	87,  // 57: todo.TaskTemplate.task:type_name -> todo.TaskTemplateItem
+	87,  // 58: todo.TaskTemplate.subtasks:type_name -> todo.TaskTemplateItem
+	87,  // 59: todo.CreateTaskTemplateRequest.task:type_name -> todo.TaskTemplateItem
+	87,  // 60: todo.CreateTaskTemplateRequest.subtasks:type_name -> todo.TaskTemplateItem
+	88,  // 61: todo.CreateTaskTemplateResponse.template:type_name -> todo.TaskTemplate
+	88,  // 62: todo.ListTaskTemplatesResponse.templates:type_name -> todo.TaskTemplate
+	88,  // 63: todo.GetTaskTemplateResponse.template:type_name -> todo.TaskTemplate
+	87,  // 64: todo.UpdateTaskTemplateRequest.task:type_name -> todo.TaskTemplateItem
+	87,  // 65: todo.UpdateTaskTemplateRequest.subtasks:type_name -> todo.TaskTemplateItem
+	88,  // 66: todo.UpdateTaskTemplateResponse.template:type_name -> todo.TaskTemplate
+	132, // 67: todo.InstantiateTemplateRequest.variables:type_name -> todo.InstantiateTemplateRequest.VariablesEntry
+	22,  // 68: todo.InstantiateTemplateResponse.tasks:type_name -> todo.Task
+	22,  // 69: todo.AssignTaskResponse.task:type_name -> todo.Task
+	8,   // 70: todo.Workspace.role:type_name -> todo.WorkspaceRole
+	8,   // 71: todo.WorkspaceInvitation.role:type_name -> todo.WorkspaceRole
+	103, // 72: todo.CreateWorkspaceResponse.workspace:type_name -> todo.Workspace
+	103, // 73: todo.ListWorkspacesResponse.workspaces:type_name -> todo.Workspace
+	8,   // 74: todo.InviteToWorkspaceRequest.role:type_name -> todo.WorkspaceRole
+	104, // 75: todo.InviteToWorkspaceResponse.invitation:type_name -> todo.WorkspaceInvitation
+	104, // 76: todo.ListWorkspaceInvitationsResponse.invitations:type_name -> todo.WorkspaceInvitation
+	103, // 77: todo.RespondToWorkspaceInvitationResponse.workspace:type_name -> todo.Workspace
+	117, // 78: todo.CreateSessionResponse.session:type_name -> todo.Session
+	117, // 79: todo.RefreshSessionResponse.session:type_name -> todo.Session
+	117, // 80: todo.GetSessionResponse.session:type_name -> todo.Session
+	117, // 81: todo.ListSessionsResponse.sessions:type_name -> todo.Session
+	117, // 82: todo.SwitchSessionWorkspaceResponse.session:type_name -> todo.Session
+	10,  // 83: todo.DataBaseService.CreateUser:input_type -> todo.CreateUserRequest
+	12,  // 84: todo.DataBaseService.GetUserByUsername:input_type -> todo.GetUserByUsernameRequest
+	14,  // 85: todo.DataBaseService.Authenticate:input_type -> todo.AuthenticateRequest
+	16,  // 86: todo.DataBaseService.DeleteUserByID:input_type -> todo.DeleteUserByIDRequest
+	18,  // 87: todo.DataBaseService.GetUserSettings:input_type -> todo.GetUserSettingsRequest
+	20,  // 88: todo.DataBaseService.UpdateUserSettings:input_type -> todo.UpdateUserSettingsRequest
+	23,  // 89: todo.DataBaseService.CreateTask:input_type -> todo.CreateTaskRequest
+	25,  // 90: todo.DataBaseService.GetTask:input_type -> todo.GetTaskRequest
+	29,  // 91: todo.DataBaseService.GetTasks:input_type -> todo.GetTasksRequest
+	31,  // 92: todo.DataBaseService.UpdateTask:input_type -> todo.UpdateTaskRequest
+	34,  // 93: todo.DataBaseService.DeleteTasksByID:input_type -> todo.DeleteTasksByIDRequest
+	37,  // 94: todo.DataBaseService.BulkUpdateTasks:input_type -> todo.BulkUpdateTasksRequest
+	41,  // 95: todo.DataBaseService.ImportTasks:input_type -> todo.ImportTasksRequest
+	44,  // 96: todo.DataBaseService.GetTaskStats:input_type -> todo.GetTaskStatsRequest
+	58,  // 97: todo.DataBaseService.GetWorkflow:input_type -> todo.GetWorkflowRequest
+	60,  // 98: todo.DataBaseService.UpdateWorkflow:input_type -> todo.UpdateWorkflowRequest
+	63,  // 99: todo.DataBaseService.StartTimer:input_type -> todo.StartTimerRequest
+	65,  // 100: todo.DataBaseService.StopTimer:input_type -> todo.StopTimerRequest
+	67,  // 101: todo.DataBaseService.AddTimeEntry:input_type -> todo.AddTimeEntryRequest
+	69,  // 102: todo.DataBaseService.ListTimeEntries:input_type -> todo.ListTimeEntriesRequest
+	71,  // 103: todo.DataBaseService.GetTimeReport:input_type -> todo.GetTimeReportRequest
+	75,  // 104: todo.DataBaseService.ShareTask:input_type -> todo.ShareTaskRequest
+	77,  // 105: todo.DataBaseService.UnshareTask:input_type -> todo.UnshareTaskRequest
+	79,  // 106: todo.DataBaseService.ListCollaborators:input_type -> todo.ListCollaboratorsRequest
+	101, // 107: todo.DataBaseService.AssignTask:input_type -> todo.AssignTaskRequest
+	81,  // 108: todo.DataBaseService.MoveTask:input_type -> todo.MoveTaskRequest
+	83,  // 109: todo.DataBaseService.Undo:input_type -> todo.UndoRequest
+	85,  // 110: todo.DataBaseService.Redo:input_type -> todo.RedoRequest
+	89,  // 111: todo.DataBaseService.CreateTaskTemplate:input_type -> todo.CreateTaskTemplateRequest
+	91,  // 112: todo.DataBaseService.ListTaskTemplates:input_type -> todo.ListTaskTemplatesRequest
+	93,  // 113: todo.DataBaseService.GetTaskTemplate:input_type -> todo.GetTaskTemplateRequest
+	95,  // 114: todo.DataBaseService.UpdateTaskTemplate:input_type -> todo.UpdateTaskTemplateRequest
+	97,  // 115: todo.DataBaseService.DeleteTaskTemplate:input_type -> todo.DeleteTaskTemplateRequest
+	99,  // 116: todo.DataBaseService.InstantiateTemplate:input_type -> todo.InstantiateTemplateRequest
+	105, // 117: todo.DataBaseService.CreateWorkspace:input_type -> todo.CreateWorkspaceRequest
+	107, // 118: todo.DataBaseService.ListWorkspaces:input_type -> todo.ListWorkspacesRequest
+	109, // 119: todo.DataBaseService.InviteToWorkspace:input_type -> todo.InviteToWorkspaceRequest
+	111, // 120: todo.DataBaseService.ListWorkspaceInvitations:input_type -> todo.ListWorkspaceInvitationsRequest
+	113, // 121: todo.DataBaseService.RespondToWorkspaceInvitation:input_type -> todo.RespondToWorkspaceInvitationRequest
+	115, // 122: todo.DataBaseService.LeaveWorkspace:input_type -> todo.LeaveWorkspaceRequest
+	49,  // 123: todo.DataBaseService.CreateCalendarFeed:input_type -> todo.CreateCalendarFeedRequest
+	51,  // 124: todo.DataBaseService.RevokeCalendarFeed:input_type -> todo.RevokeCalendarFeedRequest
+	53,  // 125: todo.DataBaseService.ResolveCalendarFeed:input_type -> todo.ResolveCalendarFeedRequest
+	118, // 126: todo.DataBaseService.CreateSession:input_type -> todo.CreateSessionRequest
+	120, // 127: todo.DataBaseService.RefreshSession:input_type -> todo.RefreshSessionRequest
+	122, // 128: todo.DataBaseService.GetSession:input_type -> todo.GetSessionRequest
+	124, // 129: todo.DataBaseService.ListSessions:input_type -> todo.ListSessionsRequest
+	126, // 130: todo.DataBaseService.RevokeSession:input_type -> todo.RevokeSessionRequest
+	128, // 131: todo.DataBaseService.RevokeAllSessions:input_type -> todo.RevokeAllSessionsRequest
+	130, // 132: todo.DataBaseService.SwitchSessionWorkspace:input_type -> todo.SwitchSessionWorkspaceRequest
+	11,  // 133: todo.DataBaseService.CreateUser:output_type -> todo.CreateUserResponse
+	13,  // 134: todo.DataBaseService.GetUserByUsername:output_type -> todo.GetUserByUsernameResponse
+	15,  // 135: todo.DataBaseService.Authenticate:output_type -> todo.AuthenticateResponse
+	17,  // 136: todo.DataBaseService.DeleteUserByID:output_type -> todo.DeleteUserByIDResponse
+	19,  // 137: todo.DataBaseService.GetUserSettings:output_type -> todo.GetUserSettingsResponse
+	21,  // 138: todo.DataBaseService.UpdateUserSettings:output_type -> todo.UpdateUserSettingsResponse
+	24,  // 139: todo.DataBaseService.CreateTask:output_type -> todo.CreateTaskResponse
+	26,  // 140: todo.DataBaseService.GetTask:output_type -> todo.GetTaskResponse
+	30,  // 141: todo.DataBaseService.GetTasks:output_type -> todo.GetTasksResponse
+	33,  // 142: todo.DataBaseService.UpdateTask:output_type -> todo.UpdateTaskResponse
+	35,  // 143: todo.DataBaseService.DeleteTasksByID:output_type -> todo.DeleteTasksByIDResponse
+	39,  // 144: todo.DataBaseService.BulkUpdateTasks:output_type -> todo.BulkUpdateTasksResponse
+	43,  // 145: todo.DataBaseService.ImportTasks:output_type -> todo.ImportTasksResponse
+	48,  // 146: todo.DataBaseService.GetTaskStats:output_type -> todo.GetTaskStatsResponse
+	59,  // 147: todo.DataBaseService.GetWorkflow:output_type -> todo.GetWorkflowResponse
+	61,  // 148: todo.DataBaseService.UpdateWorkflow:output_type -> todo.UpdateWorkflowResponse
+	64,  // 149: todo.DataBaseService.StartTimer:output_type -> todo.StartTimerResponse
+	66,  // 150: todo.DataBaseService.StopTimer:output_type -> todo.StopTimerResponse
+	68,  // 151: todo.DataBaseService.AddTimeEntry:output_type -> todo.AddTimeEntryResponse
+	70,  // 152: todo.DataBaseService.ListTimeEntries:output_type -> todo.ListTimeEntriesResponse
+	73,  // 153: todo.DataBaseService.GetTimeReport:output_type -> todo.GetTimeReportResponse
+	76,  // 154: todo.DataBaseService.ShareTask:output_type -> todo.ShareTaskResponse
+	78,  // 155: todo.DataBaseService.UnshareTask:output_type -> todo.UnshareTaskResponse
+	80,  // 156: todo.DataBaseService.ListCollaborators:output_type -> todo.ListCollaboratorsResponse
+	102, // 157: todo.DataBaseService.AssignTask:output_type -> todo.AssignTaskResponse
+	82,  // 158: todo.DataBaseService.MoveTask:output_type -> todo.MoveTaskResponse
+	84,  // 159: todo.DataBaseService.Undo:output_type -> todo.UndoResponse
+	86,  // 160: todo.DataBaseService.Redo:output_type -> todo.RedoResponse
+	90,  // 161: todo.DataBaseService.CreateTaskTemplate:output_type -> todo.CreateTaskTemplateResponse
+	92,  // 162: todo.DataBaseService.ListTaskTemplates:output_type -> todo.ListTaskTemplatesResponse
+	94,  // 163: todo.DataBaseService.GetTaskTemplate:output_type -> todo.GetTaskTemplateResponse
+	96,  // 164: todo.DataBaseService.UpdateTaskTemplate:output_type -> todo.UpdateTaskTemplateResponse
+	98,  // 165: todo.DataBaseService.DeleteTaskTemplate:output_type -> todo.DeleteTaskTemplateResponse
+	100, // 166: todo.DataBaseService.InstantiateTemplate:output_type -> todo.InstantiateTemplateResponse
+	106, // 167: todo.DataBaseService.CreateWorkspace:output_type -> todo.CreateWorkspaceResponse
+	108, // 168: todo.DataBaseService.ListWorkspaces:output_type -> todo.ListWorkspacesResponse
+	110, // 169: todo.DataBaseService.InviteToWorkspace:output_type -> todo.InviteToWorkspaceResponse
+	112, // 170: todo.DataBaseService.ListWorkspaceInvitations:output_type -> todo.ListWorkspaceInvitationsResponse
+	114, // 171: todo.DataBaseService.RespondToWorkspaceInvitation:output_type -> todo.RespondToWorkspaceInvitationResponse
+	116, // 172: todo.DataBaseService.LeaveWorkspace:output_type -> todo.LeaveWorkspaceResponse
+	50,  // 173: todo.DataBaseService.CreateCalendarFeed:output_type -> todo.CreateCalendarFeedResponse
+	52,  // 174: todo.DataBaseService.RevokeCalendarFeed:output_type -> todo.RevokeCalendarFeedResponse
+	54,  // 175: todo.DataBaseService.ResolveCalendarFeed:output_type -> todo.ResolveCalendarFeedResponse
+	119, // 176: todo.DataBaseService.CreateSession:output_type -> todo.CreateSessionResponse
+	121, // 177: todo.DataBaseService.RefreshSession:output_type -> todo.RefreshSessionResponse
+	123, // 178: todo.DataBaseService.GetSession:output_type -> todo.GetSessionResponse
+	125, // 179: todo.DataBaseService.ListSessions:output_type -> todo.ListSessionsResponse
+	127, // 180: todo.DataBaseService.RevokeSession:output_type -> todo.RevokeSessionResponse
+	129, // 181: todo.DataBaseService.RevokeAllSessions:output_type -> todo.RevokeAllSessionsResponse
+	131, // 182: todo.DataBaseService.SwitchSessionWorkspace:output_type -> todo.SwitchSessionWorkspaceResponse
+	133, // [133:183] is the sub-list for method output_type
+	83,  // [83:133] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_todo_proto_init() }
@@ -1493,15 +8193,21 @@ func file_todo_proto_init() {
 	if File_todo_proto != nil {
 		return
 	}
-	file_todo_proto_msgTypes[14].OneofWrappers = []any{}
-	file_todo_proto_msgTypes[16].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[18].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[20].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[22].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[27].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[28].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[55].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[72].OneofWrappers = []any{}
+	file_todo_proto_msgTypes[105].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_proto_rawDesc), len(file_todo_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   20,
+			NumEnums:      9,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -15,8 +15,9 @@ document.getElementById("theme-btn").addEventListener("click", setTheme);
 let title = "";
 let orderByField = 0;
 let orderByDirection = 0;
-let taskStatuses = [0, 1, 2];
+let taskStatuses = [];
 let taskPriorities = [0, 1, 2];
+let dueFilters = {};
 
 function filters() {
     const isFiltersOpen = document.getElementById("filters").classList.toggle("show");
@@ -26,15 +27,144 @@ function filters() {
     const checkedStatuses = document.querySelectorAll("#filters-statuses input.filters-checkbox:checked");
     const checkedPriorities = document.querySelectorAll("#filters-priorities input.filters-checkbox:checked");
 
-    taskStatuses = checkedStatuses.length ? Array.from(checkedStatuses).map(status => Number(status.value)) : [0, 1, 2];
+    taskStatuses = Array.from(checkedStatuses).map(status => Number(status.value));
 
     taskPriorities = checkedPriorities.length ? Array.from(checkedPriorities).map(priority => Number(priority.value)) : [0, 1, 2];
 
+    dueFilters = {
+        overdue_only: document.getElementById("filters-overdue").checked,
+        due_after: document.getElementById("filters-due-after").value,
+        due_before: document.getElementById("filters-due-before").value
+    };
+
     loadTasks(taskStatuses, taskPriorities, orderByField, orderByDirection, title);
 }
 
 document.getElementById("filters-btn")?.addEventListener("click", filters);
 
+function formatDuration(seconds) {
+    if (!seconds) return "-";
+
+    const hours = seconds / 3600;
+    return hours < 48 ? `${Math.round(hours)}h` : `${Math.round(hours / 24)}d`;
+}
+
+async function loadStats() {
+    const params = new URLSearchParams({
+        bucket: document.getElementById("stats-bucket").value,
+        timezone: userTimezone
+    });
+
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/tasks/stats?${params}`);
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        const stats = await resp.json();
+
+        const byStatus = Object.fromEntries(stats.open_by_status.map(s => [s.status, s.count]));
+        const byPriority = Object.fromEntries(stats.open_by_priority.map(p => [p.priority, p.count]));
+
+        document.getElementById("stats-todo").textContent = byStatus[0] ?? 0;
+        document.getElementById("stats-in-progress").textContent = byStatus[1] ?? 0;
+        document.getElementById("stats-overdue").textContent = stats.overdue_count;
+        document.getElementById("stats-completed").textContent = stats.completed_count;
+        document.getElementById("stats-avg").textContent = formatDuration(stats.avg_completion_seconds);
+        document.getElementById("stats-cycle").textContent = formatDuration(stats.avg_cycle_time_seconds);
+        document.getElementById("stats-low").textContent = byPriority[0] ?? 0;
+        document.getElementById("stats-medium").textContent = byPriority[1] ?? 0;
+        document.getElementById("stats-high").textContent = byPriority[2] ?? 0;
+        document.getElementById("stats-estimate").textContent = formatDuration(stats.open_estimate_minutes * 60);
+        document.getElementById("stats-points").textContent = stats.open_story_points;
+
+        const chart = document.getElementById("stats-chart");
+        chart.replaceChildren();
+        const max = Math.max(1, ...stats.completed.map(b => b.count));
+        stats.completed.forEach(b => {
+            const bar = document.createElement("div");
+            bar.style.height = `${b.count / max * 100}%`;
+            bar.title = `${new Date(b.start * 1000).toLocaleDateString()}: ${b.count}`;
+            chart.appendChild(bar);
+        });
+    } catch (error) {
+        console.error("Failed to load stats:", error);
+    }
+}
+
+document.getElementById("stats-btn")?.addEventListener("click", () => {
+    if (document.getElementById("stats").classList.toggle("show")) loadStats();
+});
+
+document.getElementById("stats-bucket")?.addEventListener("change", loadStats);
+
+// statuses of the user's workflow, replaced by loadWorkflow
+let statusOptions = [
+    { text: "status todo", value: 0 },
+    { text: "status in progress", value: 1 },
+    { text: "status done", value: 2 }
+];
+
+async function loadWorkflow() {
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/workflow/`);
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        const workflow = await resp.json();
+
+        statusOptions = workflow.statuses.map(s => ({ text: `status ${s.name}`, value: s.id }));
+
+        const filterStatuses = document.getElementById("filters-statuses");
+        filterStatuses.querySelectorAll("label").forEach(label => label.remove());
+        workflow.statuses.forEach(s => {
+            const label = document.createElement("label");
+            label.append(s.name);
+            const input = document.createElement("input");
+            input.type = "checkbox";
+            input.classList.add("filters-checkbox");
+            input.value = s.id;
+            const checkmark = document.createElement("span");
+            checkmark.classList.add("checkmark");
+            label.append(input, checkmark);
+            filterStatuses.appendChild(label);
+        });
+
+        const bulkStatus = document.getElementById("bulk-status");
+        bulkStatus.replaceChildren(bulkStatus.firstElementChild);
+        statusOptions.forEach(o => bulkStatus.appendChild(new Option(o.text, o.value)));
+    } catch (error) {
+        console.error("Failed to load workflow:", error);
+    }
+}
+
+// the user's timezone, set to the browser's on the first visit
+let userTimezone = Intl.DateTimeFormat().resolvedOptions().timeZone;
+
+async function loadSettings() {
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/user/settings`);
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        const settings = await resp.json();
+
+        if (settings.timezone) {
+            userTimezone = settings.timezone;
+            return;
+        }
+
+        await fetch(`${API_ADDR}/api/v1/user/settings`, {
+            method: "PUT",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({ timezone: userTimezone })
+        });
+    } catch (error) {
+        console.error("Failed to load settings:", error);
+    }
+}
+
+// dueDateValue returns the YYYY-MM-DD of a task's due date, all-day tasks
+// keep their date and timed ones are shown in the user's timezone
+function dueDateValue(t) {
+    if (!t.due_date) return "";
+    const dueDate = new Date(t.due_date * 1000);
+    return dueDate.toLocaleDateString("en-CA", { timeZone: t.all_day ? "UTC" : userTimezone });
+}
+
 // textarea autoresize
 function autoResize(e) {
     e.target.style.height = "auto";
@@ -42,12 +172,12 @@ function autoResize(e) {
 }
 
 // CRUD
-async function createTask(title, description, priority, dueDate) {
+async function createTask(title, description, priority, dueDate, tags = [], project = "") {
     try {
         const resp = await fetch(`${API_ADDR}/api/v1/task/`, {
             method: "POST",
             headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ title, description, priority, due_date: dueDate })
+            body: JSON.stringify({ title, description, priority, due_date: dueDate, all_day: true, tags, project })
         });
 
         if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
@@ -58,12 +188,29 @@ async function createTask(title, description, priority, dueDate) {
     }
 }
 
+// parses a quick-add line like "Pay rent tomorrow !high #home" without creating the task
+async function parseQuickAdd(text) {
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/task/quick`, {
+            method: "POST",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({ text, preview: true })
+        });
+
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        const data = await resp.json();
+        return data.parsed.task;
+    } catch (error) {
+        console.error("Failed to parse task:", error);
+    }
+}
+
 async function updateTask(id, title, description, status, priority, dueDate) {
     try {
         const resp = await fetch(`${API_ADDR}/api/v1/task/`, {
             method: "PATCH",
             headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ id, title, description, status, priority, due_date: dueDate })
+            body: JSON.stringify({ id, title, description, status, priority, due_date: dueDate, all_day: true })
         });
 
         if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
@@ -88,6 +235,70 @@ async function deleteTasks(ids) {
     }
 }
 
+async function bulkUpdateTasks(ids, patch) {
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/task/bulk`, {
+            method: "PATCH",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({ ids, patch })
+        });
+
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        return await resp.json();
+    } catch (error) {
+        console.error("Failed to bulk update tasks:", error);
+    }
+}
+
+// multi-select
+const selectedTasks = new Set();
+
+function updateBulkBar() {
+    document.getElementById("bulk-count").innerText = `${selectedTasks.size} selected`;
+    document.getElementById("bulk-bar").classList.toggle("show", selectedTasks.size > 0);
+}
+
+function clearSelection() {
+    selectedTasks.clear();
+    document.querySelectorAll(".task-select:checked").forEach(el => el.checked = false);
+    updateBulkBar();
+}
+
+function createTaskSelect(id) {
+    const checkbox = document.createElement("input");
+    checkbox.type = "checkbox";
+    checkbox.classList.add("task-select");
+    checkbox.addEventListener("change", () => {
+        checkbox.checked ? selectedTasks.add(id) : selectedTasks.delete(id);
+        updateBulkBar();
+    });
+
+    return checkbox;
+}
+
+document.getElementById("bulk-apply-btn").addEventListener("click", async () => {
+    const status = document.getElementById("bulk-status").value;
+    const priority = document.getElementById("bulk-priority").value;
+    const project = document.getElementById("bulk-project").value.trim();
+    if (!selectedTasks.size || (status === "" && priority === "" && project === "")) return;
+
+    const patch = {};
+    if (status !== "") patch.status = Number(status);
+    if (priority !== "") patch.priority = Number(priority);
+    if (project !== "") patch.project = project === "-" ? "" : project;
+
+    const data = await bulkUpdateTasks(Array.from(selectedTasks), patch);
+    if (!data) return;
+
+    data.results.filter(r => !r.updated).forEach(r => console.error(`Failed to update task ${r.id}: ${r.error}`));
+
+    document.getElementById("bulk-project").value = "";
+    clearSelection();
+    loadTasks(taskStatuses, taskPriorities, orderByField, orderByDirection, title);
+});
+
+document.getElementById("bulk-clear-btn").addEventListener("click", clearSelection);
+
 function createSelect(className, options, selectedValue) {
     const select = document.createElement("select");
     select.classList.add(className);
@@ -109,6 +320,8 @@ async function taskEvent(e) {
 
     if (e.target.classList.contains("delete-btn") && task.id) {
         deleteTasks([task.id]);
+        selectedTasks.delete(task.id);
+        updateBulkBar();
         return;
     }
 
@@ -123,22 +336,20 @@ async function taskEvent(e) {
     const dueDateValue = dueDateEl.value ? new Date(dueDateEl.value).getTime() / 1000 : 0;
 
     if (!task.id) {
-        if (titleValue && descriptionValue && dueDateValue > 0) {
-            const newTask = await createTask(titleValue, descriptionValue, priorityValue, dueDateValue);
+        if (titleValue && descriptionValue) {
+            const tags = task.dataset.tags ? task.dataset.tags.split(",") : [];
+            const newTask = await createTask(titleValue, descriptionValue, priorityValue, dueDateValue, tags, task.dataset.project);
             if (!newTask) return;
 
             task.id = newTask.id;
+            task.appendChild(createTaskSelect(newTask.id));
 
-            const statusSelect = createSelect("task-status", [
-                { text: "status todo", value: 0 },
-                { text: "status in progress", value: 1 },
-                { text: "status done", value: 2 }
-            ], newTask.status);
+            const statusSelect = createSelect("task-status", statusOptions, newTask.status);
 
             const taskOptions = task.querySelector(".task-options");
             taskOptions.insertBefore(statusSelect, taskOptions.lastElementChild);
         }
-    } else if (titleValue && descriptionValue && priorityValue && dueDateValue > 0) {
+    } else if (titleValue && descriptionValue && priorityValue) {
         const statusValue = Number(task.querySelector(".task-status").value || 0);
         updateTask(task.id, titleValue, descriptionValue, statusValue, priorityValue, dueDateValue);
     }
@@ -156,13 +367,108 @@ document.getElementById("order-by").addEventListener("change", (e) => {
         "2": [1, 0],
         "3": [1, 1],
         "4": [2, 0],
-        "5": [2, 1]
+        "5": [2, 1],
+        "6": [3, 0],
+        "7": [3, 1],
+        "8": [4, 0],
+        "9": [4, 1],
+        "10": [5, 0],
+        "11": [5, 1],
+        "12": [6, 0],
+        "13": [6, 1],
+        "14": [7, 0],
+        "15": [7, 1],
+        "16": [8, 0],
+        "17": [8, 1],
+        "18": [9, 0]
     };
 
     [orderByField, orderByDirection] = mapping[e.target.value];
     loadTasks(taskStatuses, taskPriorities, orderByField, orderByDirection, title);
 });
 
+// ctrl+z undoes the last task operation, ctrl+shift+z or ctrl+y redoes it, text fields keep their own undo
+async function replay(action) {
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/tasks/${action}`, { method: "POST" });
+        if (!resp.ok) {
+            const data = await resp.json().catch(() => ({}));
+            throw new Error(data.error || `HTTP error. Status: ${resp.status}`);
+        }
+
+        loadTasks(taskStatuses, taskPriorities, orderByField, orderByDirection, title);
+    } catch (error) {
+        console.error(`Failed to ${action}:`, error);
+    }
+}
+
+document.addEventListener("keydown", (e) => {
+    if (!(e.ctrlKey || e.metaKey) || e.target.closest("input, textarea, select")) return;
+
+    const key = e.key.toLowerCase();
+    if (key === "z" && !e.shiftKey) {
+        e.preventDefault();
+        replay("undo");
+    } else if ((key === "z" && e.shiftKey) || key === "y") {
+        e.preventDefault();
+        replay("redo");
+    }
+});
+
+// manual order: a card dropped on another goes before or after it, depending on the half it's dropped on
+let draggedTask = null;
+
+async function moveTask(task) {
+    const neighbourID = (el) => el?.classList.contains("task") ? el.id : "";
+
+    try {
+        const resp = await fetch(`${API_ADDR}/api/v1/task/${task.id}/move`, {
+            method: "POST",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({
+                before_id: neighbourID(task.previousElementSibling),
+                after_id: neighbourID(task.nextElementSibling)
+            })
+        });
+
+        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+    } catch (error) {
+        console.error("Failed to move task:", error);
+        loadTasks(taskStatuses, taskPriorities, orderByField, orderByDirection, title);
+    }
+}
+
+function enableDrag(task) {
+    task.draggable = true;
+
+    task.addEventListener("dragstart", () => {
+        draggedTask = task;
+        task.classList.add("dragging");
+    });
+
+    task.addEventListener("dragend", () => {
+        draggedTask = null;
+        task.classList.remove("dragging");
+    });
+
+    task.addEventListener("dragover", (e) => {
+        if (draggedTask && draggedTask !== task) e.preventDefault();
+    });
+
+    task.addEventListener("drop", (e) => {
+        e.preventDefault();
+        if (!draggedTask || draggedTask === task) return;
+
+        const rect = task.getBoundingClientRect();
+        if (e.clientY > rect.top + rect.height / 2) {
+            task.after(draggedTask);
+        } else {
+            task.before(draggedTask);
+        }
+        moveTask(draggedTask);
+    });
+}
+
 document.getElementById("create-btn").addEventListener("click", () => {
     const task = document.createElement("div");
     task.classList.add("task");
@@ -170,7 +476,25 @@ document.getElementById("create-btn").addEventListener("click", () => {
     const titleInput = document.createElement("input");
     titleInput.type = "text";
     titleInput.classList.add("task-title");
-    titleInput.placeholder = "title...";
+    titleInput.placeholder = "title... (try: pay rent tomorrow !high #home)";
+    titleInput.addEventListener("change", async () => {
+        if (task.id) return;
+
+        const parsed = await parseQuickAdd(titleInput.value);
+        if (!parsed || !parsed.title) return;
+
+        titleInput.value = parsed.title;
+        prioritySelect.value = parsed.priority;
+        if (parsed.due_date) {
+            // all-day dates are the midnight UTC of the date
+            const due = new Date(parsed.due_date * 1000);
+            dueDateInput.value = parsed.all_day
+                ? due.toISOString().slice(0, 10)
+                : `${due.getFullYear()}-${String(due.getMonth() + 1).padStart(2, "0")}-${String(due.getDate()).padStart(2, "0")}`;
+        }
+        task.dataset.tags = (parsed.tags || []).join(",");
+        task.dataset.project = parsed.project || "";
+    });
 
     const descriptionTextarea = document.createElement("textarea");
     descriptionTextarea.classList.add("task-description");
@@ -222,7 +546,8 @@ document.getElementById("logout-btn").addEventListener("click", async () => {
             headers: { "Content-Type": "application/json" }
         });
 
-        if (!resp.ok) throw new Error(`HTTP error. Status: ${resp.status}`);
+        // 401 means the session is already over
+        if (!resp.ok && resp.status !== 401) throw new Error(`HTTP error. Status: ${resp.status}`);
         window.location.href = "/";
     } catch (error) {
         console.error("Logout failed:", error);
@@ -239,7 +564,9 @@ async function loadTasks(statuses, priorities, field, direction, searchTitle) {
                 page_number: 1,
                 filters: {
                     task_statuses: statuses,
-                    task_priorities: priorities
+                    task_priorities: priorities,
+                    ...dueFilters,
+                    timezone: userTimezone
                 },
                 order_by: { field, direction },
                 title: searchTitle
@@ -281,18 +608,17 @@ async function loadTasks(statuses, priorities, field, direction, searchTitle) {
                 { text: "priority high", value: 2 }
             ], t.priority);
 
-            const statusSelect = createSelect("task-status", [
-                { text: "status todo", value: 0 },
-                { text: "status in progress", value: 1 },
-                { text: "status done", value: 2 }
-            ], t.status);
+            const statusSelect = createSelect("task-status", statusOptions, t.status);
 
             const dueDateInput = document.createElement("input");
             dueDateInput.type = "date";
             dueDateInput.classList.add("task-due-date");
 
-            const dueDate = new Date(t.due_date * 1000);
-            dueDateInput.value = dueDate.toISOString().split("T")[0];
+            dueDateInput.value = dueDateValue(t);
+            if (t.overdue) task.classList.add("overdue");
+            if (t.due_date && !t.all_day) {
+                dueDateInput.title = new Date(t.due_date * 1000).toLocaleString(undefined, { timeZone: userTimezone });
+            }
 
             taskOptions.appendChild(prioritySelect);
             taskOptions.appendChild(statusSelect);
@@ -306,8 +632,11 @@ async function loadTasks(statuses, priorities, field, direction, searchTitle) {
             task.appendChild(descriptionTextarea);
             task.appendChild(taskOptions);
             task.appendChild(deleteBtn);
+            task.appendChild(createTaskSelect(t.id));
+            if (selectedTasks.has(t.id)) task.querySelector(".task-select").checked = true;
 
             task.addEventListener("focusout", taskEvent);
+            if (field === 9) enableDrag(task);
             container.appendChild(task);
         });
     } catch (error) {
@@ -315,4 +644,4 @@ async function loadTasks(statuses, priorities, field, direction, searchTitle) {
     }
 }
 
-document.addEventListener("DOMContentLoaded", loadTasks([0, 1, 2], [0, 1, 2], 0, 0, ""));
\ No newline at end of file
+document.addEventListener("DOMContentLoaded", Promise.all([loadWorkflow(), loadSettings()]).then(() => loadTasks([], [0, 1, 2], 0, 0, "")));
\ No newline at end of file
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/certs"
+)
+
+// genCerts creates a local CA with server and client certificates for development.
+//
+//	todo-db gen-certs -out ./certs -hosts localhost,127.0.0.1
+func genCerts(args []string) error {
+	fs := flag.NewFlagSet("gen-certs", flag.ContinueOnError)
+	outDir := fs.String("out", "./certs", "output directory")
+	serverName := fs.String("server-name", "todo-db-service", "server certificate common name")
+	hosts := fs.String("hosts", "localhost,127.0.0.1", "comma separated extra server DNS names and IPs")
+	clientName := fs.String("client-name", "api-service", "client certificate common name")
+	validFor := fs.Duration("valid-for", 365*24*time.Hour, "certificates lifetime")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var hostList []string
+	for host := range strings.SplitSeq(*hosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hostList = append(hostList, host)
+		}
+	}
+
+	if err := certs.Generate(certs.GenerateConfig{
+		OutDir:     *outDir,
+		ServerName: *serverName,
+		Hosts:      hostList,
+		ClientName: *clientName,
+		ValidFor:   *validFor,
+	}); err != nil {
+		return err
+	}
+
+	slog.Info("certificates generated", slog.String("dir", *outDir))
+
+	return nil
+}
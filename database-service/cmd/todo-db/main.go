@@ -8,6 +8,14 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "gen-certs" {
+		if err := genCerts(os.Args[2:]); err != nil {
+			slog.Error("gen-certs failed", slog.String("err", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := app.Run(); err != nil {
 		slog.Error("app failed", slog.String("err", err.Error()))
 		os.Exit(1)
@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/joho/godotenv"
@@ -11,7 +13,25 @@ import (
 type Config struct {
 	GRPCServer struct {
 		Addr string `yaml:"addr"`
+		TLS  struct {
+			Enabled           bool          `yaml:"enabled"`
+			CertFile          string        `yaml:"cert-file"`
+			KeyFile           string        `yaml:"key-file"`
+			ClientCAFile      string        `yaml:"client-ca-file"`     // enables mTLS when set
+			AllowedIdentities []string      `yaml:"allowed-identities"` // client cert CN or DNS names
+			ReloadInterval    time.Duration `yaml:"reload-interval"`
+		} `yaml:"tls"`
 	} `yaml:"grpc-server"`
+	// Escalation periodically raises the priority of open tasks as their due date approaches.
+	Escalation struct {
+		Enabled      bool          `yaml:"enabled"`
+		Interval     time.Duration `yaml:"interval"`
+		MediumWithin time.Duration `yaml:"medium-within"` // 0 skips raising to medium
+		HighWithin   time.Duration `yaml:"high-within"`   // 0 skips raising to high
+	} `yaml:"escalation"`
+	Identity struct {
+		SecretKey string
+	}
 	Database struct {
 		Host     string
 		Port     string
@@ -41,6 +61,25 @@ func New() (*Config, error) {
 	cfg.Database.Name = os.Getenv("DB_NAME")
 	cfg.Database.User = os.Getenv("DB_USER")
 	cfg.Database.Password = os.Getenv("DB_PASSWORD")
+	cfg.Identity.SecretKey = os.Getenv("IDENTITY_SECRET_KEY")
 
 	return &cfg, nil
 }
+
+// LogValue keeps the identity key and the database password out of logs.
+func (c Config) LogValue() slog.Value {
+	type plain Config
+	p := plain(c)
+	p.Identity.SecretKey = redact(p.Identity.SecretKey)
+	p.Database.Password = redact(p.Database.Password)
+
+	return slog.AnyValue(p)
+}
+
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return "[redacted]"
+}
@@ -11,10 +11,15 @@ import (
 	"time"
 
 	"github.com/braunkc/todo-app/database-service/config"
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
 	"github.com/braunkc/todo-app/database-service/internal/application/usecases"
 	database "github.com/braunkc/todo-app/database-service/internal/infra/database/postgres"
 	grpcServer "github.com/braunkc/todo-app/database-service/internal/interfaces/grpc"
+	"github.com/braunkc/todo-app/database-service/pkg/certs"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
 	"github.com/braunkc/todo-app/database-service/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func Run() error {
@@ -45,16 +50,53 @@ func Run() error {
 
 	usecasesService := usecases.NewUsecasesService(db)
 
-	server := grpcServer.New(usecasesService)
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if cfg.Identity.SecretKey == "" {
+		return errors.New("identity secret key is not set")
+	}
+
+	verifier := identity.NewVerifier([]byte(cfg.Identity.SecretKey))
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(grpcServer.ErrorInterceptor(l), grpcServer.IdentityInterceptor(verifier)),
+		grpc.ChainStreamInterceptor(grpcServer.ErrorStreamInterceptor(l), grpcServer.IdentityStreamInterceptor(verifier)),
+	}
+	if tlsCfg := cfg.GRPCServer.TLS; tlsCfg.Enabled {
+		reloader, err := certs.NewReloader(certs.Config{
+			CertFile: tlsCfg.CertFile,
+			KeyFile:  tlsCfg.KeyFile,
+			CAFile:   tlsCfg.ClientCAFile,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to load certificates: %w", err)
+		}
+
+		if tlsCfg.ReloadInterval > 0 {
+			go reloader.Watch(ctx, tlsCfg.ReloadInterval, func(err error) {
+				l.Error("failed to reload certificates", slog.String("err", err.Error()))
+			})
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig(tlsCfg.AllowedIdentities))))
+		l.Info("tls enabled", slog.Bool("mtls", tlsCfg.ClientCAFile != ""))
+	}
+
+	if esc := cfg.Escalation; esc.Enabled && esc.Interval > 0 {
+		go escalatePriorities(ctx, l, usecasesService, esc.Interval, &dto.EscalatePrioritiesRequest{
+			MediumWithin: esc.MediumWithin,
+			HighWithin:   esc.HighWithin,
+		})
+		l.Info("priority escalation enabled", slog.Duration("interval", esc.Interval))
+	}
+
+	server := grpcServer.New(usecasesService, opts...)
 
 	listener, err := net.Listen("tcp", cfg.GRPCServer.Addr)
 	if err != nil {
 		return fmt.Errorf("failed to create tcp listener: %w", err)
 	}
 
-	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	defer cancel()
-
 	go func() {
 		l.Info("server running")
 		if err := server.Serve(listener); err != nil {
@@ -84,3 +126,26 @@ func Run() error {
 		return nil
 	}
 }
+
+// escalatePriorities runs the priority escalation every interval until ctx is done.
+func escalatePriorities(ctx context.Context, l *slog.Logger, usecasesService usecases.UsecasesService,
+	interval time.Duration, req *dto.EscalatePrioritiesRequest) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			resp, err := usecasesService.EscalatePriorities(ctx, req)
+			if err != nil {
+				l.Error("failed to escalate task priorities", slog.String("err", err.Error()))
+				continue
+			}
+			if len(resp.UserIDs) > 0 {
+				l.Debug("escalated task priorities", slog.Int("users", len(resp.UserIDs)))
+			}
+		}
+	}
+}
@@ -1,9 +1,10 @@
 package dto
 
+import "time"
+
 type User struct {
-	ID           string
-	Username     string
-	PasswordHash string
+	ID       string
+	Username string
 }
 
 type CreateUserRequest struct {
@@ -23,12 +24,35 @@ type GetUserByUsernameResponse struct {
 	User User
 }
 
+type AuthenticateRequest struct {
+	Username string
+	Password string
+}
+
+type AuthenticateResponse struct {
+	User User
+}
+
 type DeleteUserByIDRequest struct {
 	ID string
 }
 
 type DeleteUserByIDResponse struct{}
 
+type GetUserSettingsRequest struct{}
+
+type GetUserSettingsResponse struct {
+	Timezone string
+}
+
+type UpdateUserSettingsRequest struct {
+	Timezone string
+}
+
+type UpdateUserSettingsResponse struct {
+	Timezone string
+}
+
 type TaskStatus uint8
 
 const (
@@ -37,6 +61,14 @@ const (
 	TaskStatusDone
 )
 
+type StatusCategory uint8
+
+const (
+	StatusCategoryTodo StatusCategory = iota
+	StatusCategoryActive
+	StatusCategoryDone
+)
+
 type TaskPriority uint8
 
 const (
@@ -46,21 +78,40 @@ const (
 )
 
 type Task struct {
-	ID          string
-	UserID      string
-	Title       string
-	Description string
-	Status      TaskStatus
-	Priority    TaskPriority
-	DueDate     int64
-	CreatedAt   int64
+	ID              string
+	UserID          string
+	AssigneeID      string
+	ParentID        string
+	Rank            string
+	Title           string
+	Description     string
+	Status          TaskStatus
+	StatusCategory  StatusCategory
+	Priority        TaskPriority
+	DueDate         int64
+	Project         string
+	AllDay          bool
+	Overdue         bool
+	TrackedSeconds  int64
+	EstimateMinutes uint32
+	StoryPoints     uint32
+	CreatedAt       int64
+	UpdatedAt       int64
+	StartedAt       int64
+	CompletedAt     int64
+	Tags            []string
 }
 
 type CreateTaskRequest struct {
-	Title       string
-	Description string
-	Priority    TaskPriority
-	DueDate     int64
+	Title           string
+	Description     string
+	Priority        TaskPriority
+	DueDate         int64
+	Project         string
+	AllDay          bool
+	EstimateMinutes uint32
+	StoryPoints     uint32
+	Tags            []string
 }
 
 type CreateTaskResponse struct {
@@ -78,6 +129,19 @@ type GetTaskResponse struct {
 type Filters struct {
 	TaskStatuses   []TaskStatus
 	TaskPriorities []TaskPriority
+	DueBefore      string
+	DueAfter       string
+	CreatedBefore  string
+	CreatedAfter   string
+	OverdueOnly    bool
+	HasDueDate     *bool
+	HasEstimate    *bool
+	MaxEstimate    uint32
+	StoryPoints    []uint32
+	SharedWithMe   bool
+	AssignedToMe   bool
+	AssigneeID     string
+	Timezone       string
 }
 
 type SortField uint8
@@ -86,6 +150,13 @@ const (
 	Priority SortField = iota
 	DueDate
 	CreatedAt
+	Title
+	Status
+	UpdatedAt
+	CompletedAt
+	Estimate
+	StoryPoints
+	Manual
 )
 
 type SortDirection uint8
@@ -95,9 +166,17 @@ const (
 	Desc
 )
 
+type NullsOrder uint8
+
+const (
+	NullsLast NullsOrder = iota
+	NullsFirst
+)
+
 type OrderBy struct {
 	Field     SortField
 	Direction SortDirection
+	Nulls     NullsOrder
 }
 
 type GetTasksRequest struct {
@@ -105,6 +184,7 @@ type GetTasksRequest struct {
 	PageNumber int64
 	Filters    Filters
 	OrderBy    OrderBy
+	SortKeys   []OrderBy // replaces OrderBy when not empty
 	Title      string
 }
 
@@ -115,12 +195,17 @@ type GetTasksResponse struct {
 }
 
 type UpdateTaskRequest struct {
-	ID          string
-	Title       *string
-	Description *string
-	Status      *TaskStatus
-	Priority    *TaskPriority
-	DueDate     *int64
+	ID              string
+	Title           *string
+	Description     *string
+	Status          *TaskStatus
+	Priority        *TaskPriority
+	DueDate         *int64
+	Project         *string
+	AllDay          *bool
+	EstimateMinutes *uint32
+	StoryPoints     *uint32
+	Tags            *[]string
 }
 
 type UpdateTaskResponse struct {
@@ -132,3 +217,514 @@ type DeleteTasksByIDRequest struct {
 }
 
 type DeleteTasksByIDResponse struct{}
+
+type TaskPatch struct {
+	Status   *TaskStatus
+	Priority *TaskPriority
+	DueDate  *int64
+	Project  *string // "" takes the tasks out of their project
+}
+
+type BulkUpdateTasksRequest struct {
+	IDs     []string
+	Filters *Filters
+	Patch   TaskPatch
+}
+
+type BulkUpdateTaskResult struct {
+	ID      string
+	Updated bool
+	Error   string
+}
+
+type BulkUpdateTasksResponse struct {
+	Results      []BulkUpdateTaskResult
+	UpdatedCount int64
+}
+
+type ImportTaskRow struct {
+	Line        int64
+	Title       string
+	Description string
+	Status      string
+	Priority    string
+	DueDate     string
+	Project     string
+	Tags        []string
+}
+
+type ImportTasksRequest struct {
+	DryRun bool
+	Rows   []ImportTaskRow
+}
+
+type ImportRowResult struct {
+	Line      int64
+	TaskID    string
+	Duplicate bool
+	Errors    []string
+}
+
+type ImportTasksResponse struct {
+	Results        []ImportRowResult
+	CreatedCount   int64
+	DuplicateCount int64
+	FailedCount    int64
+}
+
+type CreateCalendarFeedRequest struct{}
+
+type CreateCalendarFeedResponse struct {
+	Token string
+}
+
+type RevokeCalendarFeedRequest struct{}
+
+type RevokeCalendarFeedResponse struct{}
+
+type ResolveCalendarFeedRequest struct {
+	Token string
+}
+
+type ResolveCalendarFeedResponse struct {
+	UserID string
+}
+
+type Session struct {
+	ID              string
+	UserID          string
+	WorkspaceID     string
+	Device          string
+	IP              string
+	CreatedAt       int64
+	LastRefreshedAt int64
+	ExpiresAt       int64
+}
+
+type CreateSessionRequest struct {
+	Device string
+	IP     string
+}
+
+type CreateSessionResponse struct {
+	Session      Session
+	RefreshToken string
+}
+
+type RefreshSessionRequest struct {
+	RefreshToken string
+	Device       string
+	IP           string
+}
+
+type RefreshSessionResponse struct {
+	Session      Session
+	RefreshToken string
+}
+
+type GetSessionRequest struct {
+	ID string
+}
+
+type GetSessionResponse struct {
+	Session Session
+}
+
+type ListSessionsRequest struct{}
+
+type ListSessionsResponse struct {
+	Sessions []Session
+}
+
+type RevokeSessionRequest struct {
+	ID string
+}
+
+type RevokeSessionResponse struct{}
+
+type RevokeAllSessionsRequest struct{}
+
+type RevokeAllSessionsResponse struct{}
+
+type SwitchSessionWorkspaceRequest struct {
+	ID          string
+	WorkspaceID string
+}
+
+type SwitchSessionWorkspaceResponse struct {
+	Session Session
+}
+
+type StatsBucket uint8
+
+const (
+	BucketDay StatsBucket = iota
+	BucketWeek
+)
+
+type GetTaskStatsRequest struct {
+	From     string
+	To       string
+	Bucket   StatsBucket
+	Timezone string
+}
+
+type StatusCount struct {
+	Status TaskStatus
+	Count  int64
+}
+
+type PriorityCount struct {
+	Priority TaskPriority
+	Count    int64
+}
+
+type CompletedBucket struct {
+	Start int64
+	Count int64
+}
+
+type GetTaskStatsResponse struct {
+	OpenByStatus         []StatusCount
+	OpenByPriority       []PriorityCount
+	OverdueCount         int64
+	Completed            []CompletedBucket
+	CompletedCount       int64
+	AvgCompletionSeconds int64
+	AvgCycleTimeSeconds  int64
+	OpenEstimateMinutes  int64
+	OpenStoryPoints      int64
+	CompletedStoryPoints int64
+}
+
+type WorkflowStatus struct {
+	ID       TaskStatus
+	Name     string
+	Category StatusCategory
+}
+
+type WorkflowTransition struct {
+	From TaskStatus
+	To   TaskStatus
+}
+
+type Workflow struct {
+	Statuses    []WorkflowStatus
+	Transitions []WorkflowTransition
+	IsDefault   bool
+}
+
+type GetWorkflowRequest struct{}
+
+type GetWorkflowResponse struct {
+	Workflow Workflow
+}
+
+// UpdateWorkflowRequest replaces the user's workflow, no statuses resets it to the default one.
+type UpdateWorkflowRequest struct {
+	Statuses    []WorkflowStatus
+	Transitions []WorkflowTransition
+}
+
+type UpdateWorkflowResponse struct {
+	Workflow Workflow
+}
+
+// EscalatePrioritiesRequest raises open tasks to medium priority when their deadline
+// is within MediumWithin and to high within HighWithin. A zero duration skips that level.
+type EscalatePrioritiesRequest struct {
+	MediumWithin time.Duration
+	HighWithin   time.Duration
+}
+
+type EscalatePrioritiesResponse struct {
+	UserIDs []string
+}
+
+type TimeEntry struct {
+	ID        string
+	TaskID    string
+	Project   string
+	StartedAt int64
+	StoppedAt int64 // 0 while the timer runs
+	Seconds   int64
+}
+
+type StartTimerRequest struct {
+	TaskID  string
+	Project string
+}
+
+type StartTimerResponse struct {
+	Entry   TimeEntry
+	Stopped *TimeEntry // the timer that was running before, if any
+}
+
+type StopTimerRequest struct{}
+
+type StopTimerResponse struct {
+	Entry TimeEntry
+}
+
+type AddTimeEntryRequest struct {
+	TaskID    string
+	Project   string
+	StartedAt int64
+	StoppedAt int64
+}
+
+type AddTimeEntryResponse struct {
+	Entry TimeEntry
+}
+
+type ListTimeEntriesRequest struct {
+	TaskID   string
+	From     string
+	To       string
+	Timezone string
+}
+
+type ListTimeEntriesResponse struct {
+	Entries []TimeEntry
+}
+
+type GetTimeReportRequest struct {
+	From     string
+	To       string
+	Timezone string
+}
+
+type TimeReportRow struct {
+	Day       string
+	TaskID    string
+	TaskTitle string
+	Project   string
+	Seconds   int64
+}
+
+type GetTimeReportResponse struct {
+	Rows         []TimeReportRow
+	TotalSeconds int64
+}
+
+type TaskRole uint8
+
+const (
+	TaskRoleViewer TaskRole = iota
+	TaskRoleEditor
+	TaskRoleOwner
+)
+
+type Collaborator struct {
+	UserID   string
+	Username string
+	Role     TaskRole
+}
+
+type ShareTaskRequest struct {
+	TaskID   string
+	Username string
+	Role     TaskRole
+}
+
+type ShareTaskResponse struct {
+	Collaborator Collaborator
+}
+
+type UnshareTaskRequest struct {
+	TaskID   string
+	Username string
+}
+
+type UnshareTaskResponse struct{}
+
+type ListCollaboratorsRequest struct {
+	TaskID string
+}
+
+type ListCollaboratorsResponse struct {
+	Collaborators []Collaborator
+}
+
+type WorkspaceRole uint8
+
+const (
+	WorkspaceRoleMember WorkspaceRole = iota
+	WorkspaceRoleAdmin
+	WorkspaceRoleOwner
+)
+
+type Workspace struct {
+	ID       string
+	Name     string
+	Role     WorkspaceRole
+	Personal bool
+}
+
+type WorkspaceInvitation struct {
+	ID            string
+	WorkspaceID   string
+	WorkspaceName string
+	InvitedBy     string
+	Role          WorkspaceRole
+	CreatedAt     int64
+}
+
+type CreateWorkspaceRequest struct {
+	Name string
+}
+
+type CreateWorkspaceResponse struct {
+	Workspace Workspace
+}
+
+type ListWorkspacesRequest struct{}
+
+type ListWorkspacesResponse struct {
+	Workspaces []Workspace
+}
+
+type InviteToWorkspaceRequest struct {
+	WorkspaceID string
+	Username    string
+	Role        WorkspaceRole
+}
+
+type InviteToWorkspaceResponse struct {
+	Invitation WorkspaceInvitation
+}
+
+type ListWorkspaceInvitationsRequest struct{}
+
+type ListWorkspaceInvitationsResponse struct {
+	Invitations []WorkspaceInvitation
+}
+
+type RespondToWorkspaceInvitationRequest struct {
+	InvitationID string
+	Accept       bool
+}
+
+type RespondToWorkspaceInvitationResponse struct {
+	// the joined workspace, nil if the invitation was declined
+	Workspace *Workspace
+}
+
+type LeaveWorkspaceRequest struct {
+	WorkspaceID string
+}
+
+type LeaveWorkspaceResponse struct{}
+
+// MoveTaskRequest places the task between BeforeID, the task that ends up right before it,
+// and AfterID, the one right after it. Either is empty for the start or the end of the list.
+type MoveTaskRequest struct {
+	ID       string
+	BeforeID string
+	AfterID  string
+	Status   *TaskStatus // moves the task to another status too if set
+}
+
+type MoveTaskResponse struct {
+	Task Task
+}
+
+type AssignTaskRequest struct {
+	TaskID string
+	// empty to unassign the task
+	Username string
+}
+
+type AssignTaskResponse struct {
+	Task Task
+}
+
+type UndoRequest struct{}
+
+// UndoResponse tells what was undone, the tasks as they are now and the tasks it removed.
+type UndoResponse struct {
+	Operation      string
+	Tasks          []Task
+	DeletedTaskIDs []string
+}
+
+type RedoRequest struct{}
+
+// RedoResponse tells what was redone, the tasks as they are now and the tasks it removed.
+type RedoResponse struct {
+	Operation      string
+	Tasks          []Task
+	DeletedTaskIDs []string
+}
+
+// TaskTemplateItem is a task of a template, its title and description may hold
+// {{name}} placeholders and DueOffset is like "+3d", "" for no due date.
+type TaskTemplateItem struct {
+	Title       string
+	Description string
+	Priority    TaskPriority
+	DueOffset   string
+}
+
+type TaskTemplate struct {
+	ID        string
+	Name      string
+	Task      TaskTemplateItem
+	Tags      []string
+	Subtasks  []TaskTemplateItem
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+type CreateTaskTemplateRequest struct {
+	Name     string
+	Task     TaskTemplateItem
+	Tags     []string
+	Subtasks []TaskTemplateItem
+}
+
+type CreateTaskTemplateResponse struct {
+	Template TaskTemplate
+}
+
+type ListTaskTemplatesRequest struct{}
+
+type ListTaskTemplatesResponse struct {
+	Templates []TaskTemplate
+}
+
+type GetTaskTemplateRequest struct {
+	ID string
+}
+
+type GetTaskTemplateResponse struct {
+	Template TaskTemplate
+}
+
+type UpdateTaskTemplateRequest struct {
+	ID       string
+	Name     string
+	Task     TaskTemplateItem
+	Tags     []string
+	Subtasks []TaskTemplateItem
+}
+
+type UpdateTaskTemplateResponse struct {
+	Template TaskTemplate
+}
+
+type DeleteTaskTemplateRequest struct {
+	ID string
+}
+
+type DeleteTaskTemplateResponse struct{}
+
+type InstantiateTemplateRequest struct {
+	TemplateID string
+	Variables  map[string]string
+}
+
+// InstantiateTemplateResponse holds the created task first, then its subtasks.
+type InstantiateTemplateResponse struct {
+	Tasks []Task
+}
@@ -8,13 +8,76 @@ import (
 )
 
 type Repository interface {
-	CreateUser(ctx context.Context, user *entities.User) (*entities.User, error)
+	CreateUser(ctx context.Context, user *entities.User, workspace *entities.Workspace, owner *entities.Membership) (*entities.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*entities.User, error)
+	GetUserByID(ctx context.Context, id string) (*entities.User, error)
+	UpdateUser(ctx context.Context, user *entities.User) error
 	DeleteUserByID(ctx context.Context, id string) error
 
 	CreateTask(ctx context.Context, task *entities.Task) (*entities.Task, error)
 	GetTask(ctx context.Context, ID string) (*entities.Task, error)
+	GetTasksByIDs(ctx context.Context, IDs []string) ([]*entities.Task, error)
 	GetTasks(ctx context.Context, query *valueobjects.GetTasksQuery) ([]*entities.Task, int64, int64, error)
 	UpdateTask(ctx context.Context, task *entities.Task) (*entities.Task, error)
+	AssignTask(ctx context.Context, task *entities.Task, assignment *entities.TaskAssignment) error
+	CreateTasks(ctx context.Context, tasks []*entities.Task) error
+	GetLastTaskRank(ctx context.Context, userID, workspaceID string) (string, error)
+	RebalanceTaskRanks(ctx context.Context, userID, workspaceID string) error
 	DeleteTasks(ctx context.Context, IDs []string) error
+	GetUserTasksByIDs(ctx context.Context, userID, workspaceID string, IDs []string) ([]*entities.Task, error)
+	GetUserTasksByFilters(ctx context.Context, userID, workspaceID string, filters valueobjects.TaskFilters, limit int) ([]*entities.Task, error)
+	UpdateTasks(ctx context.Context, tasks []*entities.Task) error
+	RaisePriorities(ctx context.Context, dueBefore int64, priority valueobjects.TaskPriority) ([]string, error)
+	GetImportedTaskIDs(ctx context.Context, userID string, rowHashes []string) (map[string]string, error)
+	CreateImportedTasks(ctx context.Context, tasks []*entities.Task, rowHashes []string) error
+	GetTaskStats(ctx context.Context, query *valueobjects.TaskStatsQuery) (*valueobjects.TaskStats, error)
+
+	RecordTaskCommand(ctx context.Context, command *entities.TaskCommand, depth int, notBefore int64) error
+	GetLastTaskCommand(ctx context.Context, userID, workspaceID string, undone bool, notBefore int64) (*entities.TaskCommand, error)
+	ReplayTaskCommand(ctx context.Context, command *entities.TaskCommand) error
+	// Transaction runs fn with a repository whose calls share one database transaction,
+	// committed if fn returns nil and rolled back otherwise.
+	Transaction(ctx context.Context, fn func(repo Repository) error) error
+
+	SaveTaskTemplate(ctx context.Context, template *entities.TaskTemplate) error
+	GetTaskTemplate(ctx context.Context, ID string) (*entities.TaskTemplate, error)
+	GetUserTaskTemplates(ctx context.Context, userID string) ([]*entities.TaskTemplate, error)
+	DeleteTaskTemplate(ctx context.Context, ID string) error
+
+	GetWorkflow(ctx context.Context, userID string) (*entities.Workflow, error)
+	SaveWorkflow(ctx context.Context, workflow *entities.Workflow) error
+
+	SaveCalendarFeed(ctx context.Context, feed *entities.CalendarFeed) error
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, userID string) error
+
+	CreateSession(ctx context.Context, session *entities.Session, token *entities.RefreshToken) error
+	GetSession(ctx context.Context, ID string) (*entities.Session, error)
+	GetUserSessions(ctx context.Context, userID string, now int64) ([]*entities.Session, error)
+	SaveSession(ctx context.Context, session *entities.Session) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, used *entities.RefreshToken, session *entities.Session, next *entities.RefreshToken) error
+	DeleteSession(ctx context.Context, ID string) error
+	DeleteUserSessions(ctx context.Context, userID string) error
+
+	GetTaskCollaborator(ctx context.Context, taskID, userID string) (*entities.TaskCollaborator, error)
+	GetTaskCollaborators(ctx context.Context, taskID string) ([]*entities.TaskCollaborator, error)
+	SaveTaskCollaborator(ctx context.Context, collaborator *entities.TaskCollaborator) error
+	DeleteTaskCollaborator(ctx context.Context, taskID, userID string) error
+
+	CreateWorkspace(ctx context.Context, workspace *entities.Workspace, owner *entities.Membership) error
+	GetWorkspace(ctx context.Context, ID string) (*entities.Workspace, error)
+	GetMembership(ctx context.Context, workspaceID, userID string) (*entities.Membership, error)
+	GetUserMemberships(ctx context.Context, userID string) ([]*entities.Membership, error)
+	DeleteMembership(ctx context.Context, workspaceID, userID string) error
+	SaveWorkspaceInvitation(ctx context.Context, invitation *entities.WorkspaceInvitation) error
+	GetWorkspaceInvitation(ctx context.Context, ID string) (*entities.WorkspaceInvitation, error)
+	GetUserWorkspaceInvitations(ctx context.Context, userID string) ([]*entities.WorkspaceInvitation, error)
+	AcceptWorkspaceInvitation(ctx context.Context, invitation *entities.WorkspaceInvitation, membership *entities.Membership) error
+	DeleteWorkspaceInvitation(ctx context.Context, ID string) error
+
+	GetRunningTimeEntry(ctx context.Context, userID string) (*entities.TimeEntry, error)
+	SaveTimeEntries(ctx context.Context, entries ...*entities.TimeEntry) error
+	GetTimeEntries(ctx context.Context, query *valueobjects.TimeEntriesQuery) ([]*entities.TimeEntry, error)
+	GetTrackedSeconds(ctx context.Context, taskIDs []string, now int64) (map[string]int64, error)
 }
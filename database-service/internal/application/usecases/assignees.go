@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+// AssignTask makes a member of the task's workspace work on it, an empty username unassigns it.
+// Users who can edit the task can assign it.
+func (u *usecasesService) AssignTask(ctx context.Context, req *dto.AssignTaskRequest) (*dto.AssignTaskResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.TaskID, taskvo.TaskRole.CanEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigneeID string
+	if req.Username != "" {
+		assignee, err := u.repo.GetUserByUsername(ctx, req.Username)
+		if err != nil {
+			return nil, errors.ErrNotFound
+		}
+
+		membership, err := u.repo.GetMembership(ctx, task.WorkspaceID(), assignee.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if membership == nil {
+			return nil, errors.ErrNotFound
+		}
+		assigneeID = assignee.ID()
+	}
+
+	before := task.Snapshot()
+	if assignment := task.Assign(assigneeID, userID); assignment != nil {
+		err := u.inTransaction(ctx, func(tx *usecasesService) error {
+			if err := tx.repo.AssignTask(ctx, task, assignment); err != nil {
+				return err
+			}
+
+			return tx.record(ctx, userID, workspaceID, entities.TaskOperationAssign, entities.NewTaskChange(before, task))
+		})
+		if err != nil {
+			return nil, err
+		}
+		u.stats.invalidate(task.UserID())
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []dto.Task{mapTaskToDTO(task, loc)}
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &dto.AssignTaskResponse{
+		Task: tasks[0],
+	}, nil
+}
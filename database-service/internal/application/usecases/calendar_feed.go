@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+func (u *usecasesService) CreateCalendarFeed(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*dto.CreateCalendarFeedResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	feed, token, err := entities.NewCalendarFeed(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveCalendarFeed(ctx, feed); err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateCalendarFeedResponse{
+		Token: token,
+	}, nil
+}
+
+func (u *usecasesService) RevokeCalendarFeed(ctx context.Context, req *dto.RevokeCalendarFeedRequest) (*dto.RevokeCalendarFeedResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	return &dto.RevokeCalendarFeedResponse{}, u.repo.DeleteCalendarFeed(ctx, userID)
+}
+
+// ResolveCalendarFeed returns the owner of a feed token.
+// It's called without an identity assertion, the token itself is the credential.
+func (u *usecasesService) ResolveCalendarFeed(ctx context.Context, req *dto.ResolveCalendarFeedRequest) (*dto.ResolveCalendarFeedResponse, error) {
+	if req.Token == "" {
+		return nil, errors.ErrEmptyField
+	}
+
+	feed, err := u.repo.GetCalendarFeedByTokenHash(ctx, entities.HashCalendarFeedToken(req.Token))
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	return &dto.ResolveCalendarFeedResponse{
+		UserID: feed.UserID(),
+	}, nil
+}
@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+	"github.com/google/uuid"
+)
+
+// ShareTask shares the task with a user or changes their role, only owners can share.
+func (u *usecasesService) ShareTask(ctx context.Context, req *dto.ShareTaskRequest) (*dto.ShareTaskResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.TaskID, taskvo.TaskRole.CanManage)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	// the task's user is its owner and can't get another role
+	if user.ID() == task.UserID() {
+		return nil, errors.ErrInvalidField
+	}
+
+	// tasks of a team are only shared within its workspace,
+	// personal tasks can be shared with anyone
+	if !task.InPersonalWorkspace() {
+		membership, err := u.repo.GetMembership(ctx, task.WorkspaceID(), user.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if membership == nil {
+			return nil, errors.ErrNotFound
+		}
+	}
+
+	collaborator, err := entities.NewTaskCollaborator(task.ID(), user.ID(), user.Username(), uint8(req.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveTaskCollaborator(ctx, collaborator); err != nil {
+		return nil, err
+	}
+
+	return &dto.ShareTaskResponse{
+		Collaborator: mapCollaboratorToDTO(collaborator),
+	}, nil
+}
+
+// UnshareTask removes a user from the task. Owners can remove anyone but the task's user,
+// other collaborators only themselves.
+func (u *usecasesService) UnshareTask(ctx context.Context, req *dto.UnshareTaskRequest) (*dto.UnshareTaskResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	user, err := u.repo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	allowed := taskvo.TaskRole.CanManage
+	if user.ID() == userID {
+		allowed = func(taskvo.TaskRole) bool { return true }
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.TaskID, allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID() == task.UserID() {
+		return nil, errors.ErrInvalidField
+	}
+
+	if err := u.repo.DeleteTaskCollaborator(ctx, task.ID(), user.ID()); err != nil {
+		return nil, err
+	}
+
+	return &dto.UnshareTaskResponse{}, nil
+}
+
+// ListCollaborators returns the task's owner followed by the users it's shared with.
+func (u *usecasesService) ListCollaborators(ctx context.Context, req *dto.ListCollaboratorsRequest) (*dto.ListCollaboratorsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.TaskID, func(taskvo.TaskRole) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := u.repo.GetUserByID(ctx, task.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators, err := u.repo.GetTaskCollaborators(ctx, task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ListCollaboratorsResponse{
+		Collaborators: make([]dto.Collaborator, 0, len(collaborators)+1),
+	}
+	resp.Collaborators = append(resp.Collaborators, dto.Collaborator{
+		UserID:   owner.ID(),
+		Username: owner.Username(),
+		Role:     dto.TaskRoleOwner,
+	})
+	for _, collaborator := range collaborators {
+		resp.Collaborators = append(resp.Collaborators, mapCollaboratorToDTO(collaborator))
+	}
+
+	return resp, nil
+}
+
+// authorizeTask loads the task and checks the role the user has on it passes allowed.
+// Tasks of other workspaces are only found if they're shared with the user.
+// The assignee of a task can edit it unless it's shared with them with a higher role.
+// Tasks the user has no role on or of other workspaces are reported as not found,
+// so their existence doesn't leak.
+func (u *usecasesService) authorizeTask(ctx context.Context, userID, workspaceID, taskID string,
+	allowed func(taskvo.TaskRole) bool) (*entities.Task, taskvo.TaskRole, error) {
+	if _, err := uuid.Parse(taskID); err != nil {
+		return nil, 0, errors.ErrInvalidField
+	}
+
+	task, err := u.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, 0, errors.ErrNotFound
+	}
+
+	// collaborators reach the task from any of their workspaces,
+	// owners and assignees only from the task's one
+	inWorkspace := task.WorkspaceID() == workspaceID
+	role := taskvo.TaskRoleOwner
+	if task.UserID() != userID {
+		collaborator, err := u.repo.GetTaskCollaborator(ctx, taskID, userID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch {
+		case task.AssigneeID() == userID && inWorkspace && (collaborator == nil || !collaborator.Role().CanEdit()):
+			role = taskvo.TaskRoleEditor
+		case collaborator != nil:
+			role = collaborator.Role()
+		default:
+			return nil, 0, errors.ErrNotFound
+		}
+	} else if !inWorkspace {
+		return nil, 0, errors.ErrNotFound
+	}
+
+	if !allowed(role) {
+		return nil, 0, errors.ErrPermissionDenied
+	}
+
+	return task, role, nil
+}
+
+func mapCollaboratorToDTO(c *entities.TaskCollaborator) dto.Collaborator {
+	return dto.Collaborator{
+		UserID:   c.UserID(),
+		Username: c.Username(),
+		Role:     dto.TaskRole(c.Role()),
+	}
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+)
+
+// EscalatePriorities raises the priority of open tasks whose deadline is near
+// for all users. Tasks already past their due date are raised as well.
+func (u *usecasesService) EscalatePriorities(ctx context.Context, req *dto.EscalatePrioritiesRequest) (*dto.EscalatePrioritiesResponse, error) {
+	now := time.Now()
+	levels := []struct {
+		within   time.Duration
+		priority valueobjects.TaskPriority
+	}{
+		{req.HighWithin, valueobjects.TaskPriorityHigh},
+		{req.MediumWithin, valueobjects.TaskPriorityMedium},
+	}
+
+	seen := make(map[string]bool)
+	var userIDs []string
+	for _, level := range levels {
+		if level.within <= 0 {
+			continue
+		}
+
+		changed, err := u.repo.RaisePriorities(ctx, now.Add(level.within).Unix(), level.priority)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userID := range changed {
+			if !seen[userID] {
+				seen[userID] = true
+				userIDs = append(userIDs, userID)
+			}
+			u.stats.invalidate(userID)
+		}
+	}
+
+	return &dto.EscalatePrioritiesResponse{
+		UserIDs: userIDs,
+	}, nil
+}
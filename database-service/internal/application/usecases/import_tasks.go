@@ -0,0 +1,223 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+const maxImportBatch = 1000
+
+// ImportTasks validates a batch of rows and creates a task for every valid row.
+// Rows that were already imported (same row hash) are reported as duplicates.
+// In dry-run mode nothing is written.
+func (u *usecasesService) ImportTasks(ctx context.Context, req *dto.ImportTasksRequest) (*dto.ImportTasksResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if len(req.Rows) > maxImportBatch {
+		return nil, errors.ErrInvalidField
+	}
+
+	hashes := make([]string, len(req.Rows))
+	for i, row := range req.Rows {
+		hashes[i] = rowHash(row)
+	}
+
+	imported, err := u.repo.GetImportedTaskIDs(ctx, userID, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := dto.ImportTasksResponse{
+		Results: make([]dto.ImportRowResult, 0, len(req.Rows)),
+	}
+
+	var tasks []*entities.Task
+	var taskHashes []string
+	seen := make(map[string]bool, len(req.Rows))
+	for i, row := range req.Rows {
+		result := dto.ImportRowResult{
+			Line: row.Line,
+		}
+
+		if taskID, ok := imported[hashes[i]]; ok || seen[hashes[i]] {
+			result.TaskID = taskID
+			result.Duplicate = true
+			resp.DuplicateCount++
+			resp.Results = append(resp.Results, result)
+			continue
+		}
+		seen[hashes[i]] = true
+
+		task, rowErrors := taskFromImportRow(userID, workspaceID, row, workflow)
+		if len(rowErrors) > 0 {
+			result.Errors = rowErrors
+			resp.FailedCount++
+			resp.Results = append(resp.Results, result)
+			continue
+		}
+
+		if !req.DryRun {
+			result.TaskID = task.ID()
+		}
+		tasks = append(tasks, task)
+		taskHashes = append(taskHashes, hashes[i])
+		resp.CreatedCount++
+		resp.Results = append(resp.Results, result)
+	}
+
+	if !req.DryRun && len(tasks) > 0 {
+		err := u.inTransaction(ctx, func(tx *usecasesService) error {
+			if err := tx.rankLast(ctx, userID, workspaceID, tasks); err != nil {
+				return err
+			}
+
+			if err := tx.repo.CreateImportedTasks(ctx, tasks, taskHashes); err != nil {
+				return err
+			}
+
+			created, err := tx.rebalanceRanks(ctx, tasks)
+			if err != nil {
+				return err
+			}
+
+			changes := make([]entities.TaskChange, 0, len(created))
+			for _, task := range created {
+				changes = append(changes, entities.NewTaskChange(nil, task))
+			}
+
+			return tx.record(ctx, userID, workspaceID, entities.TaskOperationImport, changes...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		u.stats.invalidate(userID)
+	}
+
+	return &resp, nil
+}
+
+// taskFromImportRow validates every field through its value object
+// and reports all failures of the row at once. Due dates without a time
+// (YYYY-MM-DD) make all-day tasks, past due dates are kept.
+func taskFromImportRow(userID, workspaceID string, row dto.ImportTaskRow, workflow *entities.Workflow) (*entities.Task, []string) {
+	var rowErrors []string
+	addErr := func(field string, err error) {
+		rowErrors = append(rowErrors, field+": "+err.Error())
+	}
+
+	title, err := taskvo.NewTaskTitle(strings.TrimSpace(row.Title))
+	if err != nil {
+		addErr("title", err)
+	}
+
+	description, err := taskvo.NewDescription(row.Description)
+	if err != nil {
+		addErr("description", err)
+	}
+
+	status, err := importStatus(row.Status, workflow)
+	if err != nil {
+		addErr("status", err)
+	}
+
+	priority, err := taskvo.ParseTaskPriority(row.Priority)
+	if err != nil {
+		addErr("priority", err)
+	}
+
+	dueDate, allDay, err := taskvo.ParseImportedDueDate(row.DueDate)
+	if err != nil {
+		addErr("due_date", err)
+	}
+
+	project, err := taskvo.NewTaskProject(row.Project)
+	if err != nil {
+		addErr("project", err)
+	}
+
+	tags, err := taskvo.NewTaskTags(row.Tags)
+	if err != nil {
+		addErr("tags", err)
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	task, err := entities.NewImportedTask(userID, workspaceID, string(*title), string(*description),
+		uint8(status), uint8(*priority), int64(*dueDate), allDay, workflow)
+	if err != nil {
+		return nil, []string{err.Error()}
+	}
+
+	if err := task.UpdateProject(string(*project)); err != nil {
+		return nil, []string{"project: " + err.Error()}
+	}
+
+	if err := task.UpdateTags(tags.List()); err != nil {
+		return nil, []string{"tags: " + err.Error()}
+	}
+
+	return task, nil
+}
+
+// importStatus resolves a status by its name in the workflow, then as a default status
+// name or number. Rows without a status start in the workflow's initial status.
+func importStatus(name string, workflow *entities.Workflow) (taskvo.TaskStatus, error) {
+	if strings.TrimSpace(name) == "" {
+		return workflow.InitialStatus().ID, nil
+	}
+
+	if status, ok := workflow.StatusByName(name); ok {
+		return status.ID, nil
+	}
+
+	status, err := taskvo.ParseTaskStatus(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := workflow.Status(uint8(*status)); !ok {
+		return 0, errors.ErrInvalidField
+	}
+
+	return *status, nil
+}
+
+func rowHash(row dto.ImportTaskRow) string {
+	fields := []string{row.Title, row.Description, row.Status, row.Priority, row.DueDate}
+	// rows without a project and tags hash as they did before rows had them
+	if row.Project != "" || len(row.Tags) > 0 {
+		fields = append(fields, row.Project)
+	}
+	if len(row.Tags) > 0 {
+		fields = append(fields, strings.Join(row.Tags, ","))
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
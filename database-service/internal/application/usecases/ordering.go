@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+// MoveTask places a task between two tasks of its owner's list in the workspace and,
+// if a status is given, moves it to that status like an update would.
+// Users who can edit the task can move it.
+func (u *usecasesService) MoveTask(ctx context.Context, req *dto.MoveTaskRequest) (*dto.MoveTaskResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.ID, taskvo.TaskRole.CanEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := u.neighbourRank(ctx, task, req.BeforeID)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := u.neighbourRank(ctx, task, req.AfterID)
+	if err != nil {
+		return nil, err
+	}
+	previous := task.Snapshot()
+
+	if req.Status != nil {
+		workflow, err := u.repo.GetWorkflow(ctx, task.UserID())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := task.UpdateStatus(uint8(*req.Status), workflow); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := task.MoveBetween(before, after); err != nil {
+		return nil, err
+	}
+
+	err = u.inTransaction(ctx, func(tx *usecasesService) error {
+		updated, err := tx.repo.UpdateTask(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		moved, err := tx.rebalanceRanks(ctx, []*entities.Task{updated})
+		if err != nil {
+			return err
+		}
+		task = moved[0]
+
+		return tx.record(ctx, userID, workspaceID, entities.TaskOperationMove, entities.NewTaskChange(previous, task))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil {
+		u.stats.invalidate(task.UserID())
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []dto.Task{mapTaskToDTO(task, loc)}
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &dto.MoveTaskResponse{
+		Task: tasks[0],
+	}, nil
+}
+
+// neighbourRank returns the rank of the task with the ID next to where task is moved,
+// "" for the start or the end of the list. Neighbours must be in the same list as task.
+func (u *usecasesService) neighbourRank(ctx context.Context, task *entities.Task, ID string) (string, error) {
+	if ID == "" {
+		return "", nil
+	}
+
+	if ID == task.ID() {
+		return "", errors.ErrInvalidField
+	}
+
+	neighbour, err := u.repo.GetTask(ctx, ID)
+	if err != nil {
+		return "", errors.ErrNotFound
+	}
+
+	if neighbour.UserID() != task.UserID() || neighbour.WorkspaceID() != task.WorkspaceID() {
+		return "", errors.ErrInvalidField
+	}
+
+	return neighbour.Rank(), nil
+}
+
+// rankLast places new tasks after the other tasks of the user in the workspace, in their order.
+func (u *usecasesService) rankLast(ctx context.Context, userID, workspaceID string, tasks []*entities.Task) error {
+	last, err := u.repo.GetLastTaskRank(ctx, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	ranks, err := taskvo.NewTaskRanksAfter(taskvo.TaskRank(last), len(tasks))
+	if err != nil {
+		return err
+	}
+
+	for i, task := range tasks {
+		if err := task.UpdateRank(string(ranks[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebalanceRanks spreads the ranks of the list of the saved tasks out again once moving
+// tasks into the same gaps made their ranks too long. It returns the tasks as they are saved now.
+func (u *usecasesService) rebalanceRanks(ctx context.Context, tasks []*entities.Task) ([]*entities.Task, error) {
+	for _, task := range tasks {
+		if !task.RankNeedsRebalance() {
+			continue
+		}
+
+		if err := u.repo.RebalanceTaskRanks(ctx, task.UserID(), task.WorkspaceID()); err != nil {
+			return nil, err
+		}
+
+		IDs := make([]string, 0, len(tasks))
+		for _, t := range tasks {
+			IDs = append(IDs, t.ID())
+		}
+
+		return u.repo.GetTasksByIDs(ctx, IDs)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,224 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+	"github.com/google/uuid"
+)
+
+// CreateSession starts a session of the user after logging in and returns its first refresh token.
+func (u *usecasesService) CreateSession(ctx context.Context, req *dto.CreateSessionRequest) (*dto.CreateSessionResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	session := entities.NewSession(userID, req.Device, req.IP)
+	token, refreshToken, err := session.IssueRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.CreateSession(ctx, session, token); err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateSessionResponse{
+		Session:      mapSessionToDTO(session),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshSession exchanges a refresh token for the next one and extends its session.
+// It's called without an identity assertion, the token itself is the credential.
+// A token that was already exchanged means it leaked, so the session is revoked,
+// unless it was exchanged just now by a request that raced this one.
+func (u *usecasesService) RefreshSession(ctx context.Context, req *dto.RefreshSessionRequest) (*dto.RefreshSessionResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, errors.ErrEmptyField
+	}
+
+	token, err := u.repo.GetRefreshToken(ctx, entities.HashRefreshToken(req.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	session, err := u.repo.GetSession(ctx, token.SessionID())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if session == nil || session.IsExpired(now) {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	if token.UsedAt() != 0 {
+		if !token.IsReused(now) {
+			return nil, errors.ErrInvalidRefreshToken
+		}
+
+		if err := u.repo.DeleteSession(ctx, session.ID()); err != nil {
+			return nil, err
+		}
+
+		return nil, errors.ErrRefreshTokenReused
+	}
+
+	token.Use(now)
+	session.Touch(req.Device, req.IP, now)
+	next, refreshToken, err := session.IssueRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.RotateRefreshToken(ctx, token, session, next); err != nil {
+		return nil, err
+	}
+
+	return &dto.RefreshSessionResponse{
+		Session:      mapSessionToDTO(session),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// GetSession returns the user's session while it's valid, revoked and expired sessions aren't found.
+func (u *usecasesService) GetSession(ctx context.Context, req *dto.GetSessionRequest) (*dto.GetSessionResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	session, err := u.userSession(ctx, userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetSessionResponse{
+		Session: mapSessionToDTO(session),
+	}, nil
+}
+
+func (u *usecasesService) ListSessions(ctx context.Context, req *dto.ListSessionsRequest) (*dto.ListSessionsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	sessions, err := u.repo.GetUserSessions(ctx, userID, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := dto.ListSessionsResponse{
+		Sessions: make([]dto.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, mapSessionToDTO(session))
+	}
+
+	return &resp, nil
+}
+
+// RevokeSession logs the user out on the session's device.
+func (u *usecasesService) RevokeSession(ctx context.Context, req *dto.RevokeSessionRequest) (*dto.RevokeSessionResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if _, err := u.userSession(ctx, userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.DeleteSession(ctx, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &dto.RevokeSessionResponse{}, nil
+}
+
+// RevokeAllSessions logs the user out everywhere.
+func (u *usecasesService) RevokeAllSessions(ctx context.Context, req *dto.RevokeAllSessionsRequest) (*dto.RevokeAllSessionsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	return &dto.RevokeAllSessionsResponse{}, u.repo.DeleteUserSessions(ctx, userID)
+}
+
+// SwitchSessionWorkspace makes the session work in another workspace of the user,
+// the tokens it issues from then on carry it. An empty ID is the personal workspace.
+func (u *usecasesService) SwitchSessionWorkspace(ctx context.Context, req *dto.SwitchSessionWorkspaceRequest) (*dto.SwitchSessionWorkspaceResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	session, err := u.userSession(ctx, userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID := req.WorkspaceID
+	if workspaceID == userID {
+		workspaceID = ""
+	}
+
+	if workspaceID != "" {
+		if _, err := u.membership(ctx, workspaceID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	session.SwitchWorkspace(workspaceID)
+	if err := u.repo.SaveSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &dto.SwitchSessionWorkspaceResponse{
+		Session: mapSessionToDTO(session),
+	}, nil
+}
+
+// userSession returns the user's session that hasn't expired,
+// sessions of other users aren't found.
+func (u *usecasesService) userSession(ctx context.Context, userID, ID string) (*entities.Session, error) {
+	if _, err := uuid.Parse(ID); err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	session, err := u.repo.GetSession(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil || session.UserID() != userID || session.IsExpired(time.Now()) {
+		return nil, errors.ErrNotFound
+	}
+
+	return session, nil
+}
+
+func mapSessionToDTO(session *entities.Session) dto.Session {
+	return dto.Session{
+		ID:              session.ID(),
+		UserID:          session.UserID(),
+		WorkspaceID:     session.WorkspaceID(),
+		Device:          session.Device(),
+		IP:              session.IP(),
+		CreatedAt:       session.CreatedAt(),
+		LastRefreshedAt: session.LastRefreshedAt(),
+		ExpiresAt:       session.ExpiresAt(),
+	}
+}
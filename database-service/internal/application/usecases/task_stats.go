@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+// statsTTL bounds how stale cached stats get when nothing is written,
+// overdue counts and the default range move with time.
+const statsTTL = 5 * time.Minute
+
+func (u *usecasesService) GetTaskStats(ctx context.Context, req *dto.GetTaskStatsRequest) (*dto.GetTaskStatsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := statsKey{workspaceID: workspaceID, req: *req}
+	if resp, ok := u.stats.get(userID, key); ok {
+		return resp, nil
+	}
+
+	// without a timezone the stats are bucketed in the user's
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Timezone != "" {
+		if loc, err = valueobjects.LoadTimezone(req.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	from, err := valueobjects.ParseDateBound(req.From, loc, false)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := valueobjects.ParseDateBound(req.To, loc, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var bucket valueobjects.StatsBucket
+	switch req.Bucket {
+	case dto.BucketDay:
+		bucket = valueobjects.StatsBucketDay
+	case dto.BucketWeek:
+		bucket = valueobjects.StatsBucketWeek
+	default:
+		return nil, errors.ErrInvalidField
+	}
+
+	query, err := valueobjects.NewTaskStatsQuery(userID, workspaceID, from, to, bucket, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := u.repo.GetTaskStats(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetTaskStatsResponse{
+		OverdueCount:         stats.OverdueCount,
+		CompletedCount:       stats.CompletedCount,
+		AvgCompletionSeconds: stats.AvgCompletionSeconds,
+		AvgCycleTimeSeconds:  stats.AvgCycleTimeSeconds,
+		OpenEstimateMinutes:  stats.OpenEstimateMinutes,
+		OpenStoryPoints:      stats.OpenStoryPoints,
+		CompletedStoryPoints: stats.CompletedStoryPoints,
+	}
+
+	for _, status := range workflow.Statuses() {
+		if status.Category == taskvo.StatusCategoryDone {
+			continue
+		}
+
+		resp.OpenByStatus = append(resp.OpenByStatus, dto.StatusCount{
+			Status: dto.TaskStatus(status.ID),
+			Count:  stats.OpenByStatus[valueobjects.TaskStatus(status.ID)],
+		})
+	}
+
+	for _, priority := range []valueobjects.TaskPriority{valueobjects.TaskPriorityLow, valueobjects.TaskPriorityMedium, valueobjects.TaskPriorityHigh} {
+		resp.OpenByPriority = append(resp.OpenByPriority, dto.PriorityCount{
+			Priority: dto.TaskPriority(priority),
+			Count:    stats.OpenByPriority[priority],
+		})
+	}
+
+	// the repository only returns buckets with completions, empty ones are filled with 0
+	counts := make(map[int64]int64, len(stats.Completed))
+	for _, bucket := range stats.Completed {
+		counts[bucket.Start] = bucket.Count
+	}
+
+	for _, start := range query.BucketStarts() {
+		resp.Completed = append(resp.Completed, dto.CompletedBucket{
+			Start: start.Unix(),
+			Count: counts[start.Unix()],
+		})
+	}
+
+	u.stats.set(userID, key, resp)
+
+	return resp, nil
+}
+
+type statsEntry struct {
+	resp      *dto.GetTaskStatsResponse
+	expiresAt time.Time
+}
+
+type statsKey struct {
+	workspaceID string
+	req         dto.GetTaskStatsRequest
+}
+
+// statsCache keeps stats responses per user, workspace and request until the user writes tasks.
+type statsCache struct {
+	mu      sync.Mutex
+	entries map[string]map[statsKey]statsEntry
+}
+
+func newStatsCache() *statsCache {
+	return &statsCache{
+		entries: make(map[string]map[statsKey]statsEntry),
+	}
+}
+
+func (c *statsCache) get(userID string, key statsKey) (*dto.GetTaskStatsResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID][key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.resp, true
+}
+
+func (c *statsCache) set(userID string, key statsKey, resp *dto.GetTaskStatsResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.entries[userID] == nil {
+		c.entries[userID] = make(map[statsKey]statsEntry)
+	}
+
+	for k, entry := range c.entries[userID] {
+		if now.After(entry.expiresAt) {
+			delete(c.entries[userID], k)
+		}
+	}
+
+	c.entries[userID][key] = statsEntry{
+		resp:      resp,
+		expiresAt: now.Add(statsTTL),
+	}
+}
+
+func (c *statsCache) invalidate(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userID)
+}
@@ -0,0 +1,243 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+func (u *usecasesService) CreateTaskTemplate(ctx context.Context, req *dto.CreateTaskTemplateRequest) (*dto.CreateTaskTemplateResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	task, subtasks, err := templateItemsFromDTO(req.Task, req.Subtasks)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entities.NewTaskTemplate(userID, req.Name, task, req.Tags, subtasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveTaskTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateTaskTemplateResponse{
+		Template: mapTaskTemplateToDTO(template),
+	}, nil
+}
+
+func (u *usecasesService) ListTaskTemplates(ctx context.Context, req *dto.ListTaskTemplatesRequest) (*dto.ListTaskTemplatesResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	templates, err := u.repo.GetUserTaskTemplates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := dto.ListTaskTemplatesResponse{
+		Templates: make([]dto.TaskTemplate, 0, len(templates)),
+	}
+	for _, template := range templates {
+		resp.Templates = append(resp.Templates, mapTaskTemplateToDTO(template))
+	}
+
+	return &resp, nil
+}
+
+func (u *usecasesService) GetTaskTemplate(ctx context.Context, req *dto.GetTaskTemplateRequest) (*dto.GetTaskTemplateResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	template, err := u.userTaskTemplate(ctx, userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetTaskTemplateResponse{
+		Template: mapTaskTemplateToDTO(template),
+	}, nil
+}
+
+func (u *usecasesService) UpdateTaskTemplate(ctx context.Context, req *dto.UpdateTaskTemplateRequest) (*dto.UpdateTaskTemplateResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	template, err := u.userTaskTemplate(ctx, userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, subtasks, err := templateItemsFromDTO(req.Task, req.Subtasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := template.Update(req.Name, task, req.Tags, subtasks); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveTaskTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return &dto.UpdateTaskTemplateResponse{
+		Template: mapTaskTemplateToDTO(template),
+	}, nil
+}
+
+func (u *usecasesService) DeleteTaskTemplate(ctx context.Context, req *dto.DeleteTaskTemplateRequest) (*dto.DeleteTaskTemplateResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if _, err := u.userTaskTemplate(ctx, userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.DeleteTaskTemplate(ctx, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &dto.DeleteTaskTemplateResponse{}, nil
+}
+
+// InstantiateTemplate creates the template's task and its subtasks in the active workspace
+// at once, they are undone together.
+func (u *usecasesService) InstantiateTemplate(ctx context.Context, req *dto.InstantiateTemplateRequest) (*dto.InstantiateTemplateResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	template, err := u.userTaskTemplate(ctx, userID, req.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := template.Instantiate(workspaceID, req.Variables, workflow, time.Now(), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.inTransaction(ctx, func(tx *usecasesService) error {
+		if err := tx.rankLast(ctx, userID, workspaceID, tasks); err != nil {
+			return err
+		}
+
+		if err := tx.repo.CreateTasks(ctx, tasks); err != nil {
+			return err
+		}
+
+		created, err := tx.rebalanceRanks(ctx, tasks)
+		if err != nil {
+			return err
+		}
+		tasks = created
+
+		changes := make([]entities.TaskChange, 0, len(tasks))
+		for _, task := range tasks {
+			changes = append(changes, entities.NewTaskChange(nil, task))
+		}
+
+		return tx.record(ctx, userID, workspaceID, entities.TaskOperationCreate, changes...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.stats.invalidate(userID)
+
+	resp := dto.InstantiateTemplateResponse{
+		Tasks: make([]dto.Task, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, mapTaskToDTO(task, loc))
+	}
+
+	return &resp, nil
+}
+
+// userTaskTemplate returns the user's template, templates of other users aren't found.
+func (u *usecasesService) userTaskTemplate(ctx context.Context, userID, ID string) (*entities.TaskTemplate, error) {
+	template, err := u.repo.GetTaskTemplate(ctx, ID)
+	if err != nil || template.UserID() != userID {
+		return nil, errors.ErrNotFound
+	}
+
+	return template, nil
+}
+
+func templateItemsFromDTO(task dto.TaskTemplateItem, subtasks []dto.TaskTemplateItem) (entities.TaskTemplateItem, []entities.TaskTemplateItem, error) {
+	t, err := entities.NewTaskTemplateItem(task.Title, task.Description, uint8(task.Priority), task.DueOffset)
+	if err != nil {
+		return entities.TaskTemplateItem{}, nil, err
+	}
+
+	items := make([]entities.TaskTemplateItem, 0, len(subtasks))
+	for _, subtask := range subtasks {
+		item, err := entities.NewTaskTemplateItem(subtask.Title, subtask.Description, uint8(subtask.Priority), subtask.DueOffset)
+		if err != nil {
+			return entities.TaskTemplateItem{}, nil, err
+		}
+		items = append(items, *item)
+	}
+
+	return *t, items, nil
+}
+
+func mapTaskTemplateItemToDTO(item entities.TaskTemplateItem) dto.TaskTemplateItem {
+	return dto.TaskTemplateItem{
+		Title:       item.Title(),
+		Description: item.Description(),
+		Priority:    dto.TaskPriority(item.Priority()),
+		DueOffset:   item.DueOffset(),
+	}
+}
+
+func mapTaskTemplateToDTO(template *entities.TaskTemplate) dto.TaskTemplate {
+	subtasks := make([]dto.TaskTemplateItem, 0, len(template.Subtasks()))
+	for _, subtask := range template.Subtasks() {
+		subtasks = append(subtasks, mapTaskTemplateItemToDTO(subtask))
+	}
+
+	return dto.TaskTemplate{
+		ID:        template.ID(),
+		Name:      template.Name(),
+		Task:      mapTaskTemplateItemToDTO(template.Task()),
+		Tags:      template.Tags(),
+		Subtasks:  subtasks,
+		CreatedAt: template.CreatedAt(),
+		UpdatedAt: template.UpdatedAt(),
+	}
+}
@@ -0,0 +1,309 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+// StartTimer starts a timer on the task. The user's running timer is stopped
+// first, so there's at most one running timer per user.
+func (u *usecasesService) StartTimer(ctx context.Context, req *dto.StartTimerRequest) (*dto.StartTimerResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if err := u.checkUserTask(ctx, userID, req.TaskID); err != nil {
+		return nil, err
+	}
+
+	entry, err := entities.StartTimeEntry(userID, req.TaskID, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := u.repo.GetRunningTimeEntry(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*entities.TimeEntry{entry}
+	if running != nil {
+		if err := running.Stop(); err != nil {
+			return nil, err
+		}
+		entries = []*entities.TimeEntry{running, entry}
+	}
+
+	if err := u.repo.SaveTimeEntries(ctx, entries...); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &dto.StartTimerResponse{
+		Entry: mapTimeEntryToDTO(entry, now),
+	}
+	if running != nil {
+		stopped := mapTimeEntryToDTO(running, now)
+		resp.Stopped = &stopped
+	}
+
+	return resp, nil
+}
+
+func (u *usecasesService) StopTimer(ctx context.Context, req *dto.StopTimerRequest) (*dto.StopTimerResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	entry, err := u.repo.GetRunningTimeEntry(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry == nil {
+		return nil, errors.ErrTimerNotRunning
+	}
+
+	if err := entry.Stop(); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveTimeEntries(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return &dto.StopTimerResponse{
+		Entry: mapTimeEntryToDTO(entry, time.Now()),
+	}, nil
+}
+
+func (u *usecasesService) AddTimeEntry(ctx context.Context, req *dto.AddTimeEntryRequest) (*dto.AddTimeEntryResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if err := u.checkUserTask(ctx, userID, req.TaskID); err != nil {
+		return nil, err
+	}
+
+	entry, err := entities.NewTimeEntry(userID, req.TaskID, req.Project, req.StartedAt, req.StoppedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveTimeEntries(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return &dto.AddTimeEntryResponse{
+		Entry: mapTimeEntryToDTO(entry, time.Now()),
+	}, nil
+}
+
+func (u *usecasesService) ListTimeEntries(ctx context.Context, req *dto.ListTimeEntriesRequest) (*dto.ListTimeEntriesResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	query, err := u.timeEntriesQuery(ctx, userID, req.TaskID, req.From, req.To, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := u.repo.GetTimeEntries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &dto.ListTimeEntriesResponse{
+		Entries: make([]dto.TimeEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, mapTimeEntryToDTO(entry, now))
+	}
+
+	return resp, nil
+}
+
+type timeReportKey struct {
+	day     string
+	taskID  string
+	project string
+}
+
+// GetTimeReport sums the tracked time per day, task and project. Entries are clipped
+// to the range and split at midnight in the report's timezone.
+func (u *usecasesService) GetTimeReport(ctx context.Context, req *dto.GetTimeReportRequest) (*dto.GetTimeReportResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	query, err := u.timeEntriesQuery(ctx, userID, "", req.From, req.To, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := u.repo.GetTimeEntries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seconds := make(map[timeReportKey]int64)
+	taskIDs := make([]string, 0, len(entries))
+	seenTasks := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		start := time.Unix(max(entry.StartedAt(), query.From().Unix()), 0).In(query.Location())
+		end := time.Unix(entry.StartedAt()+entry.Seconds(now), 0)
+		if to := query.To().Add(time.Second); end.After(to) {
+			end = to
+		}
+
+		for start.Before(end) {
+			next := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, query.Location())
+			if next.After(end) {
+				next = end
+			}
+
+			key := timeReportKey{
+				day:     start.Format(time.DateOnly),
+				taskID:  entry.TaskID(),
+				project: entry.Project(),
+			}
+			seconds[key] += int64(next.Sub(start) / time.Second)
+			start = next
+		}
+
+		if !seenTasks[entry.TaskID()] {
+			seenTasks[entry.TaskID()] = true
+			taskIDs = append(taskIDs, entry.TaskID())
+		}
+	}
+
+	// entries can be on tasks shared with the user
+	tasks, err := u.repo.GetTasksByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		titles[task.ID()] = task.Title()
+	}
+
+	resp := &dto.GetTimeReportResponse{
+		Rows: make([]dto.TimeReportRow, 0, len(seconds)),
+	}
+	for key, s := range seconds {
+		resp.Rows = append(resp.Rows, dto.TimeReportRow{
+			Day:       key.day,
+			TaskID:    key.taskID,
+			TaskTitle: titles[key.taskID],
+			Project:   key.project,
+			Seconds:   s,
+		})
+		resp.TotalSeconds += s
+	}
+
+	sort.Slice(resp.Rows, func(i, j int) bool {
+		a, b := resp.Rows[i], resp.Rows[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.TaskTitle != b.TaskTitle {
+			return a.TaskTitle < b.TaskTitle
+		}
+		if a.TaskID != b.TaskID {
+			return a.TaskID < b.TaskID
+		}
+		return a.Project < b.Project
+	})
+
+	return resp, nil
+}
+
+// timeEntriesQuery reads the range in timezone, the user's if it's empty.
+func (u *usecasesService) timeEntriesQuery(ctx context.Context, userID, taskID, from, to, timezone string) (*valueobjects.TimeEntriesQuery, error) {
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if timezone != "" {
+		if loc, err = valueobjects.LoadTimezone(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	fromTime, err := valueobjects.ParseDateBound(from, loc, false)
+	if err != nil {
+		return nil, err
+	}
+
+	toTime, err := valueobjects.ParseDateBound(to, loc, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return valueobjects.NewTimeEntriesQuery(userID, workspaceID, taskID, fromTime, toTime, loc)
+}
+
+// checkUserTask makes sure the task exists in the workspace and the user can edit it.
+func (u *usecasesService) checkUserTask(ctx context.Context, userID, taskID string) error {
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = u.authorizeTask(ctx, userID, workspaceID, taskID, taskvo.TaskRole.CanEdit)
+	return err
+}
+
+// withTrackedTime fills the tracked time of the tasks.
+func (u *usecasesService) withTrackedTime(ctx context.Context, tasks []dto.Task) error {
+	taskIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	tracked, err := u.repo.GetTrackedSeconds(ctx, taskIDs, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		tasks[i].TrackedSeconds = tracked[tasks[i].ID]
+	}
+
+	return nil
+}
+
+func mapTimeEntryToDTO(e *entities.TimeEntry, now time.Time) dto.TimeEntry {
+	return dto.TimeEntry{
+		ID:        e.ID(),
+		TaskID:    e.TaskID(),
+		Project:   e.Project(),
+		StartedAt: e.StartedAt(),
+		StoppedAt: e.StoppedAt(),
+		Seconds:   e.Seconds(now),
+	}
+}
@@ -0,0 +1,172 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/application/repository"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+const (
+	// undoDepth is how many task operations a user can undo in a workspace
+	undoDepth = 50
+	// undoWindow is how long a task operation can be undone
+	undoWindow = 24 * time.Hour
+)
+
+// Undo reverts the user's last task operation in the workspace.
+// Deleted tasks come back without their time entries, shares and assignment history.
+func (u *usecasesService) Undo(ctx context.Context, req *dto.UndoRequest) (*dto.UndoResponse, error) {
+	operation, tasks, deletedIDs, err := u.replay(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UndoResponse{
+		Operation:      operation,
+		Tasks:          tasks,
+		DeletedTaskIDs: deletedIDs,
+	}, nil
+}
+
+// Redo applies the task operation the user undid last again.
+func (u *usecasesService) Redo(ctx context.Context, req *dto.RedoRequest) (*dto.RedoResponse, error) {
+	operation, tasks, deletedIDs, err := u.replay(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RedoResponse{
+		Operation:      operation,
+		Tasks:          tasks,
+		DeletedTaskIDs: deletedIDs,
+	}, nil
+}
+
+// replay undoes or redoes the next command of the user's log in the workspace and returns
+// its operation, the tasks as they are now and the IDs of the tasks it removed.
+func (u *usecasesService) replay(ctx context.Context, redo bool) (string, []dto.Task, []string, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return "", nil, nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	notBefore := time.Now().Add(-undoWindow).UnixNano()
+	command, err := u.repo.GetLastTaskCommand(ctx, userID, workspaceID, redo, notBefore)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if command == nil {
+		if redo {
+			return "", nil, nil, errors.ErrNothingToRedo
+		}
+		return "", nil, nil, errors.ErrNothingToUndo
+	}
+
+	if redo {
+		err = command.Redo()
+	} else {
+		err = command.Undo()
+	}
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	steps := command.Steps()
+	for _, step := range steps {
+		if err := u.authorizeStep(ctx, userID, workspaceID, step); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	if err := u.repo.ReplayTaskCommand(ctx, command); err != nil {
+		return "", nil, nil, err
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var tasks []dto.Task
+	var deletedIDs []string
+	owners := make(map[string]bool, 1)
+	for _, step := range steps {
+		if step.After() == nil {
+			owners[step.Before().UserID()] = true
+			deletedIDs = append(deletedIDs, step.TaskID())
+			continue
+		}
+		owners[step.After().UserID()] = true
+		tasks = append(tasks, mapTaskToDTO(step.After(), loc))
+	}
+
+	for owner := range owners {
+		u.stats.invalidate(owner)
+	}
+
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return "", nil, nil, err
+	}
+
+	return command.Operation(), tasks, deletedIDs, nil
+}
+
+// authorizeStep checks the user may still make the change to the task, the rights
+// may have been taken away since the operation. Removing a task takes the right to
+// delete it, only its owner can bring it back.
+func (u *usecasesService) authorizeStep(ctx context.Context, userID, workspaceID string, step entities.TaskChange) error {
+	if step.Before() == nil {
+		if step.After().UserID() != userID {
+			return errors.ErrPermissionDenied
+		}
+		return nil
+	}
+
+	allowed := taskvo.TaskRole.CanEdit
+	if step.After() == nil {
+		allowed = taskvo.TaskRole.CanManage
+	}
+
+	if _, _, err := u.authorizeTask(ctx, userID, workspaceID, step.TaskID(), allowed); err != nil {
+		if err == errors.ErrNotFound {
+			return errors.ErrTaskChanged
+		}
+		return err
+	}
+
+	return nil
+}
+
+// inTransaction runs fn with the usecases on one repository transaction, so a task operation
+// is saved together with its entry in the undo log or not at all.
+func (u *usecasesService) inTransaction(ctx context.Context, fn func(tx *usecasesService) error) error {
+	return u.repo.Transaction(ctx, func(repo repository.Repository) error {
+		tx := *u
+		tx.repo = repo
+		return fn(&tx)
+	})
+}
+
+// record adds a task operation to the user's undo log in the workspace,
+// the operations undone before it can't be redone anymore.
+func (u *usecasesService) record(ctx context.Context, userID, workspaceID string,
+	operation entities.TaskOperation, changes ...entities.TaskChange) error {
+	command, err := entities.NewTaskCommand(userID, workspaceID, operation, changes)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.RecordTaskCommand(ctx, command, undoDepth, time.Now().Add(-undoWindow).UnixNano())
+}
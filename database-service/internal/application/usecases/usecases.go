@@ -2,35 +2,93 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/braunkc/todo-app/database-service/internal/application/dto"
 	"github.com/braunkc/todo-app/database-service/internal/application/repository"
 	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
 	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	workspacevo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/workspace"
 	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/metadata"
 )
 
 type usecasesService struct {
-	repo repository.Repository
+	repo  repository.Repository
+	stats *statsCache
 }
 
 type UsecasesService interface {
 	CreateUser(ctx context.Context, req *dto.CreateUserRequest) (*dto.CreateUserResponse, error)
 	GetUserByUsername(ctx context.Context, req *dto.GetUserByUsernameRequest) (*dto.GetUserByUsernameResponse, error)
+	Authenticate(ctx context.Context, req *dto.AuthenticateRequest) (*dto.AuthenticateResponse, error)
 	DeleteUserByID(ctx context.Context, req *dto.DeleteUserByIDRequest) (*dto.DeleteUserByIDResponse, error)
+	GetUserSettings(ctx context.Context, req *dto.GetUserSettingsRequest) (*dto.GetUserSettingsResponse, error)
+	UpdateUserSettings(ctx context.Context, req *dto.UpdateUserSettingsRequest) (*dto.UpdateUserSettingsResponse, error)
 
 	CreateTask(ctx context.Context, req *dto.CreateTaskRequest) (*dto.CreateTaskResponse, error)
 	GetTask(ctx context.Context, req *dto.GetTaskRequest) (*dto.GetTaskResponse, error)
 	GetTasks(ctx context.Context, req *dto.GetTasksRequest) (*dto.GetTasksResponse, error)
 	UpdateTask(ctx context.Context, req *dto.UpdateTaskRequest) (*dto.UpdateTaskResponse, error)
 	DeleteTasks(ctx context.Context, req *dto.DeleteTasksByIDRequest) (*dto.DeleteTasksByIDResponse, error)
+	BulkUpdateTasks(ctx context.Context, req *dto.BulkUpdateTasksRequest) (*dto.BulkUpdateTasksResponse, error)
+	ImportTasks(ctx context.Context, req *dto.ImportTasksRequest) (*dto.ImportTasksResponse, error)
+	GetTaskStats(ctx context.Context, req *dto.GetTaskStatsRequest) (*dto.GetTaskStatsResponse, error)
+	EscalatePriorities(ctx context.Context, req *dto.EscalatePrioritiesRequest) (*dto.EscalatePrioritiesResponse, error)
+
+	GetWorkflow(ctx context.Context, req *dto.GetWorkflowRequest) (*dto.GetWorkflowResponse, error)
+	UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*dto.UpdateWorkflowResponse, error)
+
+	StartTimer(ctx context.Context, req *dto.StartTimerRequest) (*dto.StartTimerResponse, error)
+	StopTimer(ctx context.Context, req *dto.StopTimerRequest) (*dto.StopTimerResponse, error)
+	AddTimeEntry(ctx context.Context, req *dto.AddTimeEntryRequest) (*dto.AddTimeEntryResponse, error)
+	ListTimeEntries(ctx context.Context, req *dto.ListTimeEntriesRequest) (*dto.ListTimeEntriesResponse, error)
+	GetTimeReport(ctx context.Context, req *dto.GetTimeReportRequest) (*dto.GetTimeReportResponse, error)
+
+	ShareTask(ctx context.Context, req *dto.ShareTaskRequest) (*dto.ShareTaskResponse, error)
+	UnshareTask(ctx context.Context, req *dto.UnshareTaskRequest) (*dto.UnshareTaskResponse, error)
+	ListCollaborators(ctx context.Context, req *dto.ListCollaboratorsRequest) (*dto.ListCollaboratorsResponse, error)
+	AssignTask(ctx context.Context, req *dto.AssignTaskRequest) (*dto.AssignTaskResponse, error)
+	MoveTask(ctx context.Context, req *dto.MoveTaskRequest) (*dto.MoveTaskResponse, error)
+	Undo(ctx context.Context, req *dto.UndoRequest) (*dto.UndoResponse, error)
+	Redo(ctx context.Context, req *dto.RedoRequest) (*dto.RedoResponse, error)
+
+	CreateTaskTemplate(ctx context.Context, req *dto.CreateTaskTemplateRequest) (*dto.CreateTaskTemplateResponse, error)
+	ListTaskTemplates(ctx context.Context, req *dto.ListTaskTemplatesRequest) (*dto.ListTaskTemplatesResponse, error)
+	GetTaskTemplate(ctx context.Context, req *dto.GetTaskTemplateRequest) (*dto.GetTaskTemplateResponse, error)
+	UpdateTaskTemplate(ctx context.Context, req *dto.UpdateTaskTemplateRequest) (*dto.UpdateTaskTemplateResponse, error)
+	DeleteTaskTemplate(ctx context.Context, req *dto.DeleteTaskTemplateRequest) (*dto.DeleteTaskTemplateResponse, error)
+	InstantiateTemplate(ctx context.Context, req *dto.InstantiateTemplateRequest) (*dto.InstantiateTemplateResponse, error)
+
+	CreateWorkspace(ctx context.Context, req *dto.CreateWorkspaceRequest) (*dto.CreateWorkspaceResponse, error)
+	ListWorkspaces(ctx context.Context, req *dto.ListWorkspacesRequest) (*dto.ListWorkspacesResponse, error)
+	InviteToWorkspace(ctx context.Context, req *dto.InviteToWorkspaceRequest) (*dto.InviteToWorkspaceResponse, error)
+	ListWorkspaceInvitations(ctx context.Context, req *dto.ListWorkspaceInvitationsRequest) (*dto.ListWorkspaceInvitationsResponse, error)
+	RespondToWorkspaceInvitation(ctx context.Context, req *dto.RespondToWorkspaceInvitationRequest) (*dto.RespondToWorkspaceInvitationResponse, error)
+	LeaveWorkspace(ctx context.Context, req *dto.LeaveWorkspaceRequest) (*dto.LeaveWorkspaceResponse, error)
+
+	CreateCalendarFeed(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*dto.CreateCalendarFeedResponse, error)
+	RevokeCalendarFeed(ctx context.Context, req *dto.RevokeCalendarFeedRequest) (*dto.RevokeCalendarFeedResponse, error)
+	ResolveCalendarFeed(ctx context.Context, req *dto.ResolveCalendarFeedRequest) (*dto.ResolveCalendarFeedResponse, error)
+
+	CreateSession(ctx context.Context, req *dto.CreateSessionRequest) (*dto.CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, req *dto.RefreshSessionRequest) (*dto.RefreshSessionResponse, error)
+	GetSession(ctx context.Context, req *dto.GetSessionRequest) (*dto.GetSessionResponse, error)
+	ListSessions(ctx context.Context, req *dto.ListSessionsRequest) (*dto.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req *dto.RevokeSessionRequest) (*dto.RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, req *dto.RevokeAllSessionsRequest) (*dto.RevokeAllSessionsResponse, error)
+	SwitchSessionWorkspace(ctx context.Context, req *dto.SwitchSessionWorkspaceRequest) (*dto.SwitchSessionWorkspaceResponse, error)
 }
 
+// dummyUser's password is checked when logging in as a user that doesn't exist.
+var dummyUser, _ = entities.NewUser("dummy", "dummy")
+
 func NewUsecasesService(repo repository.Repository) UsecasesService {
 	return &usecasesService{
-		repo: repo,
+		repo:  repo,
+		stats: newStatsCache(),
 	}
 }
 
@@ -40,16 +98,21 @@ func (u *usecasesService) CreateUser(ctx context.Context, req *dto.CreateUserReq
 		return nil, err
 	}
 
-	resp, err := u.repo.CreateUser(ctx, user)
+	workspace := entities.NewPersonalWorkspace(user)
+	owner, err := entities.NewMembership(workspace, user.ID(), uint8(workspacevo.WorkspaceRoleOwner))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.repo.CreateUser(ctx, user, workspace, owner)
 	if err != nil {
 		return nil, err
 	}
 
 	return &dto.CreateUserResponse{
 		User: dto.User{
-			ID:           resp.ID(),
-			Username:     resp.Username(),
-			PasswordHash: string(resp.PasswordHash()),
+			ID:       resp.ID(),
+			Username: resp.Username(),
 		},
 	}, nil
 }
@@ -62,106 +125,196 @@ func (u *usecasesService) GetUserByUsername(ctx context.Context, req *dto.GetUse
 
 	return &dto.GetUserByUsernameResponse{
 		User: dto.User{
-			ID:           resp.ID(),
-			Username:     resp.Username(),
-			PasswordHash: string(resp.PasswordHash()),
+			ID:       resp.ID(),
+			Username: resp.Username(),
+		},
+	}, nil
+}
+
+// Authenticate returns the user if the password is theirs.
+// It's called without an identity assertion, the password itself is the credential.
+// Unknown users and wrong passwords are reported alike.
+func (u *usecasesService) Authenticate(ctx context.Context, req *dto.AuthenticateRequest) (*dto.AuthenticateResponse, error) {
+	user, err := u.repo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		// spend as long as checking a password so that timing doesn't tell the username exists
+		dummyUser.CheckPassword(req.Password)
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	if !user.CheckPassword(req.Password) {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	return &dto.AuthenticateResponse{
+		User: dto.User{
+			ID:       user.ID(),
+			Username: user.Username(),
 		},
 	}, nil
 }
 
 func (u *usecasesService) DeleteUserByID(ctx context.Context, req *dto.DeleteUserByIDRequest) (*dto.DeleteUserByIDResponse, error) {
-	return &dto.DeleteUserByIDResponse{}, u.repo.DeleteUserByID(ctx, req.ID)
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if userID != req.ID {
+		return nil, errors.ErrPermissionDenied
+	}
+
+	if err := u.repo.DeleteUserByID(ctx, req.ID); err != nil {
+		return nil, err
+	}
+	u.stats.invalidate(req.ID)
+
+	return &dto.DeleteUserByIDResponse{}, nil
 }
 
 func (u *usecasesService) CreateTask(ctx context.Context, req *dto.CreateTaskRequest) (*dto.CreateTaskResponse, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
+	userID, ok := identity.UserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.ErrFailedGetMetadata
-	}
-	userIDs := md.Get("userID")
-	if len(userIDs) == 0 {
 		return nil, errors.ErrFailedGetUserIDFromContext
 	}
-	userID := userIDs[0]
 
-	task, err := entities.NewTask(userID, req.Title, req.Description, 0, uint8(req.Priority), req.DueDate)
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := entities.NewTask(userID, workspaceID, req.Title, req.Description,
+		uint8(workflow.InitialStatus().ID), uint8(req.Priority), req.DueDate, req.AllDay, workflow, loc)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := u.repo.CreateTask(ctx, task)
+	if err := task.UpdateProject(req.Project); err != nil {
+		return nil, err
+	}
+
+	if err := task.UpdateEstimate(req.EstimateMinutes); err != nil {
+		return nil, err
+	}
+
+	if err := task.UpdateStoryPoints(req.StoryPoints); err != nil {
+		return nil, err
+	}
+
+	if err := task.UpdateTags(req.Tags); err != nil {
+		return nil, err
+	}
+
+	var resp *entities.Task
+	err = u.inTransaction(ctx, func(tx *usecasesService) error {
+		if err := tx.rankLast(ctx, userID, workspaceID, []*entities.Task{task}); err != nil {
+			return err
+		}
+
+		created, err := tx.repo.CreateTask(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		rebalanced, err := tx.rebalanceRanks(ctx, []*entities.Task{created})
+		if err != nil {
+			return err
+		}
+		resp = rebalanced[0]
+
+		return tx.record(ctx, userID, workspaceID, entities.TaskOperationCreate, entities.NewTaskChange(nil, resp))
+	})
 	if err != nil {
 		return nil, err
 	}
+	u.stats.invalidate(userID)
 
 	return &dto.CreateTaskResponse{
-		Task: mapTaskToDTO(resp),
+		Task: mapTaskToDTO(resp, loc),
 	}, nil
 }
 
 func (u *usecasesService) GetTask(ctx context.Context, req *dto.GetTaskRequest) (*dto.GetTaskResponse, error) {
-	task, err := u.repo.GetTask(ctx, req.ID)
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.ID, func(taskvo.TaskRole) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := u.userLocation(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	tasks := []dto.Task{mapTaskToDTO(task, loc)}
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return nil, err
+	}
+
 	return &dto.GetTaskResponse{
-		Task: mapTaskToDTO(task),
+		Task: tasks[0],
 	}, nil
 }
 
 func (u *usecasesService) GetTasks(ctx context.Context, req *dto.GetTasksRequest) (*dto.GetTasksResponse, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
+	userID, ok := identity.UserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.ErrFailedGetMetadata
-	}
-	userIDs := md.Get("userID")
-	if len(userIDs) == 0 {
 		return nil, errors.ErrFailedGetUserIDFromContext
 	}
-	userID := userIDs[0]
 
-	var taskStatuses []valueobjects.TaskStatus
-	for _, status := range req.Filters.TaskStatuses {
-		if status <= dto.TaskStatus(valueobjects.TaskStatusDone) {
-			taskStatuses = append(taskStatuses, valueobjects.TaskStatus(status))
-		}
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	var taskPriorities []valueobjects.TaskPriority
-	for _, priority := range req.Filters.TaskPriorities {
-		if priority <= dto.TaskPriority(valueobjects.TaskPriorityHigh) {
-			taskPriorities = append(taskPriorities, valueobjects.TaskPriority(priority))
-		}
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	var field valueobjects.SortField
-	switch req.OrderBy.Field {
-	case dto.Priority:
-		field = valueobjects.SortByPriority
-	case dto.DueDate:
-		field = valueobjects.SortByDueDate
-	case dto.CreatedAt:
-		field = valueobjects.SortByCreatedAt
-	default:
-		field = valueobjects.SortByPriority
+	filters, err := mapFiltersToQuery(req.Filters, loc)
+	if err != nil {
+		return nil, err
 	}
 
-	var direction valueobjects.SortDirection
-	switch req.OrderBy.Direction {
-	case dto.Asc:
-		direction = valueobjects.SortAsc
-	case dto.Desc:
-		direction = valueobjects.SortDesc
-	default:
-		direction = valueobjects.SortAsc
+	sortKeys := req.SortKeys
+	if len(sortKeys) == 0 {
+		sortKeys = []dto.OrderBy{req.OrderBy}
+	}
+
+	orderBy := make([]valueobjects.TaskOrderBy, 0, len(sortKeys))
+	for _, key := range sortKeys {
+		o, err := mapOrderByToQuery(key)
+		if err != nil {
+			return nil, err
+		}
+		orderBy = append(orderBy, o)
 	}
 
 	query, err := valueobjects.NewGetTasksQuery(
-		userID,
+		userID, workspaceID,
 		req.PageSize, req.PageNumber,
-		field, direction,
-		taskStatuses, taskPriorities,
+		orderBy,
+		filters,
 		req.Title,
 	)
 	if err != nil {
@@ -175,7 +328,11 @@ func (u *usecasesService) GetTasks(ctx context.Context, req *dto.GetTasksRequest
 
 	var tasks []dto.Task
 	for _, task := range resp {
-		tasks = append(tasks, mapTaskToDTO(task))
+		tasks = append(tasks, mapTaskToDTO(task, loc))
+	}
+
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return nil, err
 	}
 
 	return &dto.GetTasksResponse{
@@ -186,14 +343,21 @@ func (u *usecasesService) GetTasks(ctx context.Context, req *dto.GetTasksRequest
 }
 
 func (u *usecasesService) UpdateTask(ctx context.Context, req *dto.UpdateTaskRequest) (*dto.UpdateTaskResponse, error) {
-	if _, err := uuid.Parse(req.ID); err != nil {
-		return nil, errors.ErrInvalidField
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	task, err := u.repo.GetTask(ctx, req.ID)
+	task, _, err := u.authorizeTask(ctx, userID, workspaceID, req.ID, taskvo.TaskRole.CanEdit)
 	if err != nil {
 		return nil, err
 	}
+	before := task.Snapshot()
 
 	if req.Title != nil {
 		if err := task.UpdateTitle(*req.Title); err != nil {
@@ -208,7 +372,12 @@ func (u *usecasesService) UpdateTask(ctx context.Context, req *dto.UpdateTaskReq
 	}
 
 	if req.Status != nil {
-		if err := task.UpdateStatus(uint8(*req.Status)); err != nil {
+		workflow, err := u.repo.GetWorkflow(ctx, task.UserID())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := task.UpdateStatus(uint8(*req.Status), workflow); err != nil {
 			return nil, err
 		}
 	}
@@ -219,35 +388,386 @@ func (u *usecasesService) UpdateTask(ctx context.Context, req *dto.UpdateTaskReq
 		}
 	}
 
-	if req.DueDate != nil {
-		if err := task.UpdateDueDate(*req.DueDate); err != nil {
+	if req.EstimateMinutes != nil {
+		if err := task.UpdateEstimate(*req.EstimateMinutes); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.StoryPoints != nil {
+		if err := task.UpdateStoryPoints(*req.StoryPoints); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Tags != nil {
+		if err := task.UpdateTags(*req.Tags); err != nil {
 			return nil, err
 		}
 	}
 
-	task, err = u.repo.UpdateTask(ctx, task)
+	// switching between all-day and timed keeps the due date, 0 clears it
+	if req.DueDate != nil || req.AllDay != nil {
+		dueDate, allDay := task.DueDate(), task.AllDay()
+		if req.DueDate != nil {
+			dueDate = *req.DueDate
+		}
+		if req.AllDay != nil {
+			allDay = *req.AllDay
+		}
+
+		if err := task.UpdateDueDate(dueDate, allDay); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Project != nil {
+		if err := task.UpdateProject(*req.Project); err != nil {
+			return nil, err
+		}
+	}
+
+	err = u.inTransaction(ctx, func(tx *usecasesService) error {
+		updated, err := tx.repo.UpdateTask(ctx, task)
+		if err != nil {
+			return err
+		}
+		task = updated
+
+		return tx.record(ctx, userID, workspaceID, entities.TaskOperationUpdate, entities.NewTaskChange(before, task))
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.stats.invalidate(task.UserID())
+
+	loc, err := u.userLocation(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	tasks := []dto.Task{mapTaskToDTO(task, loc)}
+	if err := u.withTrackedTime(ctx, tasks); err != nil {
+		return nil, err
+	}
+
 	return &dto.UpdateTaskResponse{
-		Task: mapTaskToDTO(task),
+		Task: tasks[0],
 	}, nil
 }
 
+// DeleteTasks deletes the tasks only if the user owns all of them.
 func (u *usecasesService) DeleteTasks(ctx context.Context, req *dto.DeleteTasksByIDRequest) (*dto.DeleteTasksByIDResponse, error) {
-	return &dto.DeleteTasksByIDResponse{}, u.repo.DeleteTasks(ctx, req.IDs)
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]bool, 1)
+	changes := make([]entities.TaskChange, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		task, _, err := u.authorizeTask(ctx, userID, workspaceID, id, taskvo.TaskRole.CanManage)
+		if err != nil {
+			return nil, err
+		}
+		owners[task.UserID()] = true
+		changes = append(changes, entities.NewTaskChange(task, nil))
+	}
+
+	err = u.inTransaction(ctx, func(tx *usecasesService) error {
+		if err := tx.repo.DeleteTasks(ctx, req.IDs); err != nil {
+			return err
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+		return tx.record(ctx, userID, workspaceID, entities.TaskOperationDelete, changes...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for owner := range owners {
+		u.stats.invalidate(owner)
+	}
+
+	return &dto.DeleteTasksByIDResponse{}, nil
+}
+
+const maxBulkTasks = 1000
+
+func (u *usecasesService) BulkUpdateTasks(ctx context.Context, req *dto.BulkUpdateTasksRequest) (*dto.BulkUpdateTasksResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	// exactly one selector must be set
+	if (len(req.IDs) == 0) == (req.Filters == nil) || len(req.IDs) > maxBulkTasks {
+		return nil, errors.ErrInvalidField
+	}
+
+	if req.Patch.Status == nil && req.Patch.Priority == nil && req.Patch.DueDate == nil && req.Patch.Project == nil {
+		return nil, errors.ErrEmptyField
+	}
+
+	loc, err := u.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := u.activeWorkspace(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []dto.BulkUpdateTaskResult
+	var tasks []*entities.Task
+	if len(req.IDs) > 0 {
+		validIDs := make([]string, 0, len(req.IDs))
+		for _, id := range req.IDs {
+			if _, err := uuid.Parse(id); err != nil {
+				results = append(results, dto.BulkUpdateTaskResult{ID: id, Error: errors.ErrInvalidField.Error()})
+				continue
+			}
+			validIDs = append(validIDs, id)
+		}
+
+		found, err := u.repo.GetUserTasksByIDs(ctx, userID, workspaceID, validIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]*entities.Task, len(found))
+		for _, task := range found {
+			byID[task.ID()] = task
+		}
+
+		for _, id := range validIDs {
+			task, ok := byID[id]
+			if !ok {
+				results = append(results, dto.BulkUpdateTaskResult{ID: id, Error: errors.ErrNotFound.Error()})
+				continue
+			}
+			tasks = append(tasks, task)
+		}
+	} else {
+		// bulk updates only touch the user's own tasks
+		if req.Filters.SharedWithMe || req.Filters.AssignedToMe {
+			return nil, errors.ErrInvalidField
+		}
+
+		filters, err := mapFiltersToQuery(*req.Filters, loc)
+		if err != nil {
+			return nil, err
+		}
+		if err := filters.Validate(); err != nil {
+			return nil, err
+		}
+
+		found, err := u.repo.GetUserTasksByFilters(ctx, userID, workspaceID, filters, maxBulkTasks)
+		if err != nil {
+			return nil, err
+		}
+		tasks = found
+	}
+
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]*entities.Task, 0, len(tasks))
+	changes := make([]entities.TaskChange, 0, len(tasks))
+	for _, task := range tasks {
+		before := task.Snapshot()
+		if err := applyTaskPatch(task, req.Patch, workflow); err != nil {
+			results = append(results, dto.BulkUpdateTaskResult{ID: task.ID(), Error: err.Error()})
+			continue
+		}
+
+		updated = append(updated, task)
+		changes = append(changes, entities.NewTaskChange(before, task))
+		results = append(results, dto.BulkUpdateTaskResult{ID: task.ID(), Updated: true})
+	}
+
+	if len(updated) > 0 {
+		err := u.inTransaction(ctx, func(tx *usecasesService) error {
+			if err := tx.repo.UpdateTasks(ctx, updated); err != nil {
+				return err
+			}
+
+			return tx.record(ctx, userID, workspaceID, entities.TaskOperationBulkUpdate, changes...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		u.stats.invalidate(userID)
+	}
+
+	return &dto.BulkUpdateTasksResponse{
+		Results:      results,
+		UpdatedCount: int64(len(updated)),
+	}, nil
+}
+
+// applyTaskPatch keeps whether the task is all-day when the due date changes.
+func applyTaskPatch(task *entities.Task, patch dto.TaskPatch, workflow *entities.Workflow) error {
+	if patch.Status != nil {
+		if err := task.UpdateStatus(uint8(*patch.Status), workflow); err != nil {
+			return err
+		}
+	}
+
+	if patch.Priority != nil {
+		if err := task.UpdatePriority(uint8(*patch.Priority)); err != nil {
+			return err
+		}
+	}
+
+	if patch.DueDate != nil {
+		if err := task.UpdateDueDate(*patch.DueDate, task.AllDay()); err != nil {
+			return err
+		}
+	}
+
+	if patch.Project != nil {
+		if err := task.UpdateProject(*patch.Project); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapFiltersToQuery reads date bounds in the filters' timezone, userLoc if it's not set.
+func mapFiltersToQuery(f dto.Filters, userLoc *time.Location) (valueobjects.TaskFilters, error) {
+	filters := valueobjects.TaskFilters{
+		OverdueOnly:  f.OverdueOnly,
+		HasDueDate:   f.HasDueDate,
+		HasEstimate:  f.HasEstimate,
+		MaxEstimate:  f.MaxEstimate,
+		StoryPoints:  f.StoryPoints,
+		SharedWithMe: f.SharedWithMe,
+		AssignedToMe: f.AssignedToMe,
+		AssigneeID:   f.AssigneeID,
+		Location:     userLoc,
+	}
+	for _, status := range f.TaskStatuses {
+		filters.Statuses = append(filters.Statuses, valueobjects.TaskStatus(status))
+	}
+
+	for _, priority := range f.TaskPriorities {
+		if priority <= dto.TaskPriority(valueobjects.TaskPriorityHigh) {
+			filters.Priorities = append(filters.Priorities, valueobjects.TaskPriority(priority))
+		}
+	}
+
+	if f.Timezone != "" {
+		loc, err := valueobjects.LoadTimezone(f.Timezone)
+		if err != nil {
+			return filters, err
+		}
+		filters.Location = loc
+	}
+
+	var err error
+	if filters.DueAfter, err = valueobjects.ParseDateBound(f.DueAfter, filters.Location, false); err != nil {
+		return filters, err
+	}
+
+	if filters.DueBefore, err = valueobjects.ParseDateBound(f.DueBefore, filters.Location, true); err != nil {
+		return filters, err
+	}
+
+	if filters.CreatedAfter, err = valueobjects.ParseDateBound(f.CreatedAfter, filters.Location, false); err != nil {
+		return filters, err
+	}
+
+	if filters.CreatedBefore, err = valueobjects.ParseDateBound(f.CreatedBefore, filters.Location, true); err != nil {
+		return filters, err
+	}
+
+	return filters, nil
+}
+
+func mapOrderByToQuery(o dto.OrderBy) (valueobjects.TaskOrderBy, error) {
+	var orderBy valueobjects.TaskOrderBy
+	switch o.Field {
+	case dto.Priority:
+		orderBy.Field = valueobjects.SortByPriority
+	case dto.DueDate:
+		orderBy.Field = valueobjects.SortByDueDate
+	case dto.CreatedAt:
+		orderBy.Field = valueobjects.SortByCreatedAt
+	case dto.Title:
+		orderBy.Field = valueobjects.SortByTitle
+	case dto.Status:
+		orderBy.Field = valueobjects.SortByStatus
+	case dto.UpdatedAt:
+		orderBy.Field = valueobjects.SortByUpdatedAt
+	case dto.CompletedAt:
+		orderBy.Field = valueobjects.SortByCompletedAt
+	case dto.Estimate:
+		orderBy.Field = valueobjects.SortByEstimate
+	case dto.StoryPoints:
+		orderBy.Field = valueobjects.SortByStoryPoints
+	case dto.Manual:
+		orderBy.Field = valueobjects.SortByManual
+	default:
+		return orderBy, errors.ErrInvalidField
+	}
+
+	switch o.Direction {
+	case dto.Asc:
+		orderBy.Direction = valueobjects.SortAsc
+	case dto.Desc:
+		orderBy.Direction = valueobjects.SortDesc
+	default:
+		return orderBy, errors.ErrInvalidField
+	}
+
+	switch o.Nulls {
+	case dto.NullsLast:
+		orderBy.Nulls = valueobjects.NullsLast
+	case dto.NullsFirst:
+		orderBy.Nulls = valueobjects.NullsFirst
+	default:
+		return orderBy, errors.ErrInvalidField
+	}
+
+	return orderBy, nil
 }
 
-func mapTaskToDTO(t *entities.Task) dto.Task {
+// mapTaskToDTO derives whether the task is overdue now in loc, the user's location.
+func mapTaskToDTO(t *entities.Task, loc *time.Location) dto.Task {
 	return dto.Task{
-		ID:          t.ID(),
-		UserID:      t.UserID(),
-		Title:       t.Title(),
-		Description: t.Description(),
-		Status:      dto.TaskStatus(t.Status()),
-		Priority:    dto.TaskPriority(t.Priority()),
-		DueDate:     t.DueDate(),
-		CreatedAt:   t.CreatedAt(),
+		ID:              t.ID(),
+		UserID:          t.UserID(),
+		AssigneeID:      t.AssigneeID(),
+		ParentID:        t.ParentID(),
+		Rank:            t.Rank(),
+		Title:           t.Title(),
+		Description:     t.Description(),
+		Status:          dto.TaskStatus(t.Status()),
+		StatusCategory:  dto.StatusCategory(t.Category()),
+		Priority:        dto.TaskPriority(t.Priority()),
+		DueDate:         t.DueDate(),
+		Project:         t.Project(),
+		AllDay:          t.AllDay(),
+		Overdue:         t.IsOverdue(time.Now(), loc),
+		EstimateMinutes: t.EstimateMinutes(),
+		StoryPoints:     t.StoryPoints(),
+		CreatedAt:       t.CreatedAt(),
+		UpdatedAt:       t.UpdatedAt(),
+		StartedAt:       t.StartedAt(),
+		CompletedAt:     t.CompletedAt(),
+		Tags:            t.Tags(),
 	}
 }
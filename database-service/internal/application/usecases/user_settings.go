@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+func (u *usecasesService) GetUserSettings(ctx context.Context, req *dto.GetUserSettingsRequest) (*dto.GetUserSettingsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	user, err := u.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetUserSettingsResponse{
+		Timezone: user.Timezone(),
+	}, nil
+}
+
+// UpdateUserSettings sets the user's timezone, an empty one means UTC.
+func (u *usecasesService) UpdateUserSettings(ctx context.Context, req *dto.UpdateUserSettingsRequest) (*dto.UpdateUserSettingsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	user, err := u.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.UpdateTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	// overdue counts depend on where the user's day ends
+	u.stats.invalidate(userID)
+
+	return &dto.UpdateUserSettingsResponse{
+		Timezone: user.Timezone(),
+	}, nil
+}
+
+// userLocation returns the location of the user's timezone.
+func (u *usecasesService) userLocation(ctx context.Context, userID string) (*time.Location, error) {
+	user, err := u.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.Location(), nil
+}
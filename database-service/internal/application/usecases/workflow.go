@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+)
+
+func (u *usecasesService) GetWorkflow(ctx context.Context, req *dto.GetWorkflowRequest) (*dto.GetWorkflowResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workflow, err := u.repo.GetWorkflow(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetWorkflowResponse{
+		Workflow: mapWorkflowToDTO(workflow),
+	}, nil
+}
+
+// UpdateWorkflow replaces the user's workflow. Statuses can only be removed
+// once no task is in them, tasks in kept statuses follow their new category.
+func (u *usecasesService) UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*dto.UpdateWorkflowResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workflow := entities.DefaultWorkflow(userID)
+	if len(req.Statuses) > 0 {
+		statuses := make([]entities.WorkflowStatus, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, entities.WorkflowStatus{
+				ID:       taskvo.TaskStatus(status.ID),
+				Name:     status.Name,
+				Category: taskvo.StatusCategory(status.Category),
+			})
+		}
+
+		transitions := make([]entities.WorkflowTransition, 0, len(req.Transitions))
+		for _, transition := range req.Transitions {
+			transitions = append(transitions, entities.WorkflowTransition{
+				From: taskvo.TaskStatus(transition.From),
+				To:   taskvo.TaskStatus(transition.To),
+			})
+		}
+
+		var err error
+		workflow, err = entities.NewWorkflow(userID, statuses, transitions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.repo.SaveWorkflow(ctx, workflow); err != nil {
+		return nil, err
+	}
+	u.stats.invalidate(userID)
+
+	return &dto.UpdateWorkflowResponse{
+		Workflow: mapWorkflowToDTO(workflow),
+	}, nil
+}
+
+func mapWorkflowToDTO(w *entities.Workflow) dto.Workflow {
+	workflow := dto.Workflow{
+		IsDefault: w.IsDefault(),
+	}
+
+	for _, status := range w.Statuses() {
+		workflow.Statuses = append(workflow.Statuses, dto.WorkflowStatus{
+			ID:       dto.TaskStatus(status.ID),
+			Name:     status.Name,
+			Category: dto.StatusCategory(status.Category),
+		})
+	}
+
+	for _, transition := range w.Transitions() {
+		workflow.Transitions = append(workflow.Transitions, dto.WorkflowTransition{
+			From: dto.TaskStatus(transition.From),
+			To:   dto.TaskStatus(transition.To),
+		})
+	}
+
+	return workflow
+}
@@ -0,0 +1,264 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/braunkc/todo-app/database-service/internal/application/dto"
+	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	workspacevo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/workspace"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+	"github.com/google/uuid"
+)
+
+// CreateWorkspace creates a team workspace owned by the user.
+func (u *usecasesService) CreateWorkspace(ctx context.Context, req *dto.CreateWorkspaceRequest) (*dto.CreateWorkspaceResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	workspace, err := entities.NewWorkspace(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := entities.NewMembership(workspace, userID, uint8(workspacevo.WorkspaceRoleOwner))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.CreateWorkspace(ctx, workspace, owner); err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateWorkspaceResponse{
+		Workspace: mapMembershipToDTO(owner),
+	}, nil
+}
+
+// ListWorkspaces returns the workspaces the user is a member of, the personal one first.
+func (u *usecasesService) ListWorkspaces(ctx context.Context, req *dto.ListWorkspacesRequest) (*dto.ListWorkspacesResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	memberships, err := u.repo.GetUserMemberships(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ListWorkspacesResponse{
+		Workspaces: make([]dto.Workspace, 0, len(memberships)),
+	}
+	for _, membership := range memberships {
+		if membership.IsPersonal() {
+			resp.Workspaces = append([]dto.Workspace{mapMembershipToDTO(membership)}, resp.Workspaces...)
+			continue
+		}
+		resp.Workspaces = append(resp.Workspaces, mapMembershipToDTO(membership))
+	}
+
+	return resp, nil
+}
+
+// InviteToWorkspace invites a user to a team workspace, only admins and the owner can invite.
+// Inviting a user again replaces their pending invitation.
+func (u *usecasesService) InviteToWorkspace(ctx context.Context, req *dto.InviteToWorkspaceRequest) (*dto.InviteToWorkspaceResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	membership, err := u.membership(ctx, req.WorkspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !membership.Role().CanInvite() || membership.IsPersonal() {
+		return nil, errors.ErrPermissionDenied
+	}
+
+	user, err := u.repo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	existing, err := u.repo.GetMembership(ctx, req.WorkspaceID, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	workspace, err := u.repo.GetWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := entities.NewWorkspaceInvitation(workspace, user.ID(), userID, uint8(req.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SaveWorkspaceInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	inviter, err := u.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InviteToWorkspaceResponse{
+		Invitation: mapInvitationToDTO(invitation, inviter.Username()),
+	}, nil
+}
+
+// ListWorkspaceInvitations returns the user's pending invitations, newest first.
+func (u *usecasesService) ListWorkspaceInvitations(ctx context.Context, req *dto.ListWorkspaceInvitationsRequest) (*dto.ListWorkspaceInvitationsResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	invitations, err := u.repo.GetUserWorkspaceInvitations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	inviters := make(map[string]string, len(invitations))
+	resp := &dto.ListWorkspaceInvitationsResponse{
+		Invitations: make([]dto.WorkspaceInvitation, 0, len(invitations)),
+	}
+	for _, invitation := range invitations {
+		username, ok := inviters[invitation.InvitedBy()]
+		if !ok {
+			// the inviter may have deleted their account since
+			if inviter, err := u.repo.GetUserByID(ctx, invitation.InvitedBy()); err == nil {
+				username = inviter.Username()
+			}
+			inviters[invitation.InvitedBy()] = username
+		}
+		resp.Invitations = append(resp.Invitations, mapInvitationToDTO(invitation, username))
+	}
+
+	return resp, nil
+}
+
+// RespondToWorkspaceInvitation accepts or declines an invitation of the user.
+func (u *usecasesService) RespondToWorkspaceInvitation(ctx context.Context, req *dto.RespondToWorkspaceInvitationRequest) (*dto.RespondToWorkspaceInvitationResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	if _, err := uuid.Parse(req.InvitationID); err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	invitation, err := u.repo.GetWorkspaceInvitation(ctx, req.InvitationID)
+	if err != nil || invitation.UserID() != userID {
+		return nil, errors.ErrNotFound
+	}
+
+	if !req.Accept {
+		if err := u.repo.DeleteWorkspaceInvitation(ctx, invitation.ID()); err != nil {
+			return nil, err
+		}
+
+		return &dto.RespondToWorkspaceInvitationResponse{}, nil
+	}
+
+	membership := invitation.Accept()
+	if err := u.repo.AcceptWorkspaceInvitation(ctx, invitation, membership); err != nil {
+		return nil, err
+	}
+
+	workspace := mapMembershipToDTO(membership)
+	return &dto.RespondToWorkspaceInvitationResponse{
+		Workspace: &workspace,
+	}, nil
+}
+
+// LeaveWorkspace removes the user from a team workspace. Owners can't leave,
+// so a workspace is never left without one.
+func (u *usecasesService) LeaveWorkspace(ctx context.Context, req *dto.LeaveWorkspaceRequest) (*dto.LeaveWorkspaceResponse, error) {
+	userID, ok := identity.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrFailedGetUserIDFromContext
+	}
+
+	membership, err := u.membership(ctx, req.WorkspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if membership.Role() == workspacevo.WorkspaceRoleOwner {
+		return nil, errors.ErrPermissionDenied
+	}
+
+	if err := u.repo.DeleteMembership(ctx, req.WorkspaceID, userID); err != nil {
+		return nil, err
+	}
+	u.stats.invalidate(userID)
+
+	return &dto.LeaveWorkspaceResponse{}, nil
+}
+
+// activeWorkspace returns the workspace the request is made in, the user's personal
+// workspace if the caller didn't choose one. The user must be a member of it.
+func (u *usecasesService) activeWorkspace(ctx context.Context, userID string) (string, error) {
+	workspaceID, ok := identity.WorkspaceIDFromContext(ctx)
+	if !ok || workspaceID == userID {
+		return userID, nil
+	}
+
+	if _, err := u.membership(ctx, workspaceID, userID); err != nil {
+		return "", err
+	}
+
+	return workspaceID, nil
+}
+
+// membership returns the user's membership of the workspace.
+// Workspaces the user isn't a member of are reported as not found.
+func (u *usecasesService) membership(ctx context.Context, workspaceID, userID string) (*entities.Membership, error) {
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	membership, err := u.repo.GetMembership(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if membership == nil {
+		return nil, errors.ErrNotFound
+	}
+
+	return membership, nil
+}
+
+func mapMembershipToDTO(m *entities.Membership) dto.Workspace {
+	return dto.Workspace{
+		ID:       m.WorkspaceID(),
+		Name:     m.WorkspaceName(),
+		Role:     dto.WorkspaceRole(m.Role()),
+		Personal: m.IsPersonal(),
+	}
+}
+
+func mapInvitationToDTO(i *entities.WorkspaceInvitation, invitedBy string) dto.WorkspaceInvitation {
+	return dto.WorkspaceInvitation{
+		ID:            i.ID(),
+		WorkspaceID:   i.WorkspaceID(),
+		WorkspaceName: i.WorkspaceName(),
+		InvitedBy:     invitedBy,
+		Role:          dto.WorkspaceRole(i.Role()),
+		CreatedAt:     i.CreatedAt(),
+	}
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CalendarFeed is a secret link that lets calendar apps read a user's tasks
+// without a session. Only the hash of the token is stored.
+type CalendarFeed struct {
+	userID    string
+	tokenHash string
+	createdAt int64
+}
+
+// NewCalendarFeed generates a new feed token for the user.
+// The plain token is returned once and can't be recovered later.
+func NewCalendarFeed(userID string) (*CalendarFeed, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return &CalendarFeed{
+		userID:    userID,
+		tokenHash: HashCalendarFeedToken(token),
+		createdAt: time.Now().Unix(),
+	}, token, nil
+}
+
+func NewCalendarFeedFromStorage(userID, tokenHash string, createdAt int64) *CalendarFeed {
+	return &CalendarFeed{
+		userID:    userID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+	}
+}
+
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (f *CalendarFeed) UserID() string {
+	return f.userID
+}
+
+func (f *CalendarFeed) TokenHash() string {
+	return f.tokenHash
+}
+
+func (f *CalendarFeed) CreatedAt() int64 {
+	return f.createdAt
+}
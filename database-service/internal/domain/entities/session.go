@@ -0,0 +1,181 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// SessionTTL is how long a session lasts without being refreshed.
+	SessionTTL = 30 * 24 * time.Hour
+	// refreshReuseGrace is how long a rotated refresh token is taken for a request that
+	// raced the rotation rather than a stolen token.
+	refreshReuseGrace = 30 * time.Second
+	maxDeviceLength   = 255
+)
+
+// Session is a login of the user on a device. It's kept alive by refresh tokens that
+// rotate on every use, only their hashes are stored.
+type Session struct {
+	id              string
+	userID          string
+	workspaceID     string
+	device          string
+	ip              string
+	createdAt       int64
+	lastRefreshedAt int64
+	expiresAt       int64
+}
+
+// NewSession starts a session in the user's personal workspace on the device
+// (its user agent) at the address.
+func NewSession(userID, device, ip string) *Session {
+	now := time.Now()
+	session := &Session{
+		id:        uuid.New().String(),
+		userID:    userID,
+		createdAt: now.Unix(),
+	}
+	session.Touch(device, ip, now)
+
+	return session
+}
+
+func NewSessionFromStorage(id, userID, workspaceID, device, ip string, createdAt, lastRefreshedAt, expiresAt int64) *Session {
+	return &Session{
+		id:              id,
+		userID:          userID,
+		workspaceID:     workspaceID,
+		device:          device,
+		ip:              ip,
+		createdAt:       createdAt,
+		lastRefreshedAt: lastRefreshedAt,
+		expiresAt:       expiresAt,
+	}
+}
+
+func (s *Session) ID() string {
+	return s.id
+}
+
+func (s *Session) UserID() string {
+	return s.userID
+}
+
+// WorkspaceID returns the workspace the session works in, "" for the personal one.
+func (s *Session) WorkspaceID() string {
+	return s.workspaceID
+}
+
+func (s *Session) Device() string {
+	return s.device
+}
+
+func (s *Session) IP() string {
+	return s.ip
+}
+
+func (s *Session) CreatedAt() int64 {
+	return s.createdAt
+}
+
+func (s *Session) LastRefreshedAt() int64 {
+	return s.lastRefreshedAt
+}
+
+func (s *Session) ExpiresAt() int64 {
+	return s.expiresAt
+}
+
+func (s *Session) IsExpired(now time.Time) bool {
+	return now.Unix() >= s.expiresAt
+}
+
+// Touch records a refresh of the session from the device at the address and extends it.
+func (s *Session) Touch(device, ip string, now time.Time) {
+	if len(device) > maxDeviceLength {
+		device = device[:maxDeviceLength]
+	}
+
+	s.device = device
+	s.ip = ip
+	s.lastRefreshedAt = now.Unix()
+	s.expiresAt = now.Add(SessionTTL).Unix()
+}
+
+// SwitchWorkspace makes the session work in the workspace, "" for the personal one.
+func (s *Session) SwitchWorkspace(workspaceID string) {
+	s.workspaceID = workspaceID
+}
+
+// IssueRefreshToken generates the next refresh token of the session.
+// The plain token is returned once and can't be recovered later.
+func (s *Session) IssueRefreshToken() (*RefreshToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return &RefreshToken{
+		tokenHash: HashRefreshToken(token),
+		sessionID: s.id,
+		createdAt: time.Now().Unix(),
+	}, token, nil
+}
+
+// RefreshToken renews a session once. Used tokens are kept with the session
+// so that presenting one again is recognized as reuse.
+type RefreshToken struct {
+	tokenHash string
+	sessionID string
+	createdAt int64
+	usedAt    int64
+}
+
+func NewRefreshTokenFromStorage(tokenHash, sessionID string, createdAt, usedAt int64) *RefreshToken {
+	return &RefreshToken{
+		tokenHash: tokenHash,
+		sessionID: sessionID,
+		createdAt: createdAt,
+		usedAt:    usedAt,
+	}
+}
+
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *RefreshToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *RefreshToken) SessionID() string {
+	return t.sessionID
+}
+
+func (t *RefreshToken) CreatedAt() int64 {
+	return t.createdAt
+}
+
+// UsedAt returns when the token was rotated, 0 if it's the session's current one.
+func (t *RefreshToken) UsedAt() int64 {
+	return t.usedAt
+}
+
+func (t *RefreshToken) Use(now time.Time) {
+	t.usedAt = now.Unix()
+}
+
+// IsReused reports whether presenting the used token at now means it was stolen:
+// it was rotated longer than a racing request could take ago.
+func (t *RefreshToken) IsReused(now time.Time) bool {
+	return t.usedAt != 0 && now.Sub(time.Unix(t.usedAt, 0)) > refreshReuseGrace
+}
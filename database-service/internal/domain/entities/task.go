@@ -3,6 +3,8 @@ package entities
 import (
 	"time"
 
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+
 	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
 	"github.com/google/uuid"
 )
@@ -10,16 +12,29 @@ import (
 type Task struct {
 	id          string
 	userID      string
+	workspaceID string
+	assigneeID  string
+	parentID    string
 	title       valueobjects.TaskTitle
 	description valueobjects.TaskDescription
 	status      valueobjects.TaskStatus
+	category    valueobjects.StatusCategory
 	priority    valueobjects.TaskPriority
 	dueDate     valueobjects.TaskDueDate
+	project     valueobjects.TaskProject
+	allDay      bool
+	estimate    valueobjects.TaskEstimate
+	storyPoints valueobjects.TaskStoryPoints
+	rank        valueobjects.TaskRank
+	tags        valueobjects.TaskTags
 	createdAt   int64
+	updatedAt   int64
+	startedAt   int64
+	completedAt int64
 }
 
-func NewTask(userID, title, description string,
-	status, priority uint8, dueDate int64) (*Task, error) {
+func NewTask(userID, workspaceID, title, description string,
+	status, priority uint8, dueDate int64, allDay bool, workflow *Workflow, loc *time.Location) (*Task, error) {
 	t, err := valueobjects.NewTaskTitle(title)
 	if err != nil {
 		return nil, err
@@ -30,9 +45,9 @@ func NewTask(userID, title, description string,
 		return nil, err
 	}
 
-	s, err := valueobjects.NewTaskStatus(status)
-	if err != nil {
-		return nil, err
+	s, ok := workflow.Status(status)
+	if !ok {
+		return nil, errors.ErrInvalidField
 	}
 
 	p, err := valueobjects.NewTaskPriority(priority)
@@ -40,37 +55,71 @@ func NewTask(userID, title, description string,
 		return nil, err
 	}
 
-	dd, err := valueobjects.NewDueDate(dueDate)
+	dd, err := newDueDate(dueDate, allDay, loc)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Task{
+	now := time.Now().Unix()
+
+	task := &Task{
 		id:          uuid.New().String(),
 		userID:      userID,
+		workspaceID: workspaceID,
 		title:       *t,
 		description: *d,
-		status:      *s,
+		status:      s.ID,
+		category:    s.Category,
 		priority:    *p,
 		dueDate:     *dd,
-		createdAt:   time.Now().Unix(),
-	}, nil
+		allDay:      allDay && *dd != 0,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+	task.trackStatus(s.Category, now)
+
+	return task, nil
 }
 
-func NewTaskFromStorage(id, userID, title, description string,
-	status, priority uint8, dueDate, createdAt int64) *Task {
+func NewTaskFromStorage(id, userID, workspaceID, assigneeID, parentID, title, description string,
+	status, category, priority uint8, dueDate int64, allDay bool, project string,
+	estimate, storyPoints uint32, rank, tags string, createdAt, updatedAt, startedAt, completedAt int64) *Task {
 	return &Task{
 		id:          id,
 		userID:      userID,
+		workspaceID: workspaceID,
+		assigneeID:  assigneeID,
+		parentID:    parentID,
 		title:       valueobjects.TaskTitle(title),
 		description: valueobjects.TaskDescription(description),
 		status:      valueobjects.TaskStatus(status),
+		category:    valueobjects.StatusCategory(category),
 		priority:    valueobjects.TaskPriority(priority),
 		dueDate:     valueobjects.TaskDueDate(dueDate),
+		project:     valueobjects.TaskProject(project),
+		allDay:      allDay,
+		estimate:    valueobjects.TaskEstimate(estimate),
+		storyPoints: valueobjects.TaskStoryPoints(storyPoints),
+		rank:        valueobjects.TaskRank(rank),
+		tags:        valueobjects.TaskTags(tags),
 		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		startedAt:   startedAt,
+		completedAt: completedAt,
 	}
 }
 
+// Snapshot returns a copy of the task's current state.
+func (t *Task) Snapshot() *Task {
+	snapshot := *t
+	return &snapshot
+}
+
+// Equal reports whether both tasks are in the same state.
+func (t *Task) Equal(other *Task) bool {
+	return *t == *other
+}
+
 func (t *Task) ID() string {
 	return t.id
 }
@@ -79,6 +128,25 @@ func (t *Task) UserID() string {
 	return t.userID
 }
 
+func (t *Task) WorkspaceID() string {
+	return t.workspaceID
+}
+
+// InPersonalWorkspace reports whether the task is in its user's personal workspace.
+func (t *Task) InPersonalWorkspace() bool {
+	return t.workspaceID == t.userID
+}
+
+// AssigneeID returns who works on the task, "" if no one is assigned.
+func (t *Task) AssigneeID() string {
+	return t.assigneeID
+}
+
+// ParentID returns the task this one is a subtask of, "" for top-level tasks.
+func (t *Task) ParentID() string {
+	return t.parentID
+}
+
 func (t *Task) Title() string {
 	return string(t.title)
 }
@@ -91,6 +159,11 @@ func (t *Task) Status() uint8 {
 	return uint8(t.status)
 }
 
+// Category returns the category of the task's status in the workflow it was last moved in.
+func (t *Task) Category() uint8 {
+	return uint8(t.category)
+}
+
 func (t *Task) Priority() uint8 {
 	return uint8(t.priority)
 }
@@ -99,10 +172,86 @@ func (t *Task) DueDate() int64 {
 	return int64(t.dueDate)
 }
 
+func (t *Task) Project() string {
+	return string(t.project)
+}
+
+// AllDay reports whether the task is due by the end of a date rather than at a time.
+// The due date of an all-day task is the midnight UTC of that date.
+func (t *Task) AllDay() bool {
+	return t.allDay
+}
+
+// EstimateMinutes returns the expected effort, 0 if the task isn't estimated.
+func (t *Task) EstimateMinutes() uint32 {
+	return uint32(t.estimate)
+}
+
+// StoryPoints returns the relative size of the task, 0 if it isn't sized.
+func (t *Task) StoryPoints() uint32 {
+	return uint32(t.storyPoints)
+}
+
+// Rank returns the place of the task in its owner's manual order.
+func (t *Task) Rank() string {
+	return string(t.rank)
+}
+
+// RankNeedsRebalance reports whether the ranks of the owner's tasks should be spread out again.
+func (t *Task) RankNeedsRebalance() bool {
+	return t.rank.NeedsRebalance()
+}
+
+// Tags returns the labels of the task in order.
+func (t *Task) Tags() []string {
+	return t.tags.List()
+}
+
 func (t *Task) CreatedAt() int64 {
 	return int64(t.createdAt)
 }
 
+func (t *Task) UpdatedAt() int64 {
+	return t.updatedAt
+}
+
+// StartedAt returns when work on the task started, 0 if it's still todo.
+func (t *Task) StartedAt() int64 {
+	return t.startedAt
+}
+
+// CompletedAt returns when the task was last moved to done, 0 if it's not done.
+func (t *Task) CompletedAt() int64 {
+	return t.completedAt
+}
+
+// trackStatus keeps the transition timestamps in line with a move to a status of category.
+// Starting keeps the first start, moving back to todo clears it and reopening
+// clears the completion. Tasks done without being started start when they are done.
+func (t *Task) trackStatus(category valueobjects.StatusCategory, now int64) {
+	switch category {
+	case valueobjects.StatusCategoryTodo:
+		t.startedAt = 0
+		t.completedAt = 0
+	case valueobjects.StatusCategoryActive:
+		if t.startedAt == 0 {
+			t.startedAt = now
+		}
+		t.completedAt = 0
+	case valueobjects.StatusCategoryDone:
+		if t.startedAt == 0 {
+			t.startedAt = now
+		}
+		if t.completedAt == 0 {
+			t.completedAt = now
+		}
+	}
+}
+
+func (t *Task) touch() {
+	t.updatedAt = time.Now().Unix()
+}
+
 func (t *Task) UpdateTitle(title string) error {
 	newTitle, err := valueobjects.NewTaskTitle(title)
 	if err != nil {
@@ -110,6 +259,7 @@ func (t *Task) UpdateTitle(title string) error {
 	}
 
 	t.title = *newTitle
+	t.touch()
 
 	return nil
 }
@@ -121,17 +271,27 @@ func (t *Task) UpdateDescription(description string) error {
 	}
 
 	t.description = *newDescription
+	t.touch()
 
 	return nil
 }
 
-func (t *Task) UpdateStatus(status uint8) error {
-	newStatus, err := valueobjects.NewTaskStatus(status)
-	if err != nil {
-		return err
+// UpdateStatus moves the task to status, which must be in the workflow
+// and reachable from the current status.
+func (t *Task) UpdateStatus(status uint8, workflow *Workflow) error {
+	newStatus, ok := workflow.Status(status)
+	if !ok {
+		return errors.ErrInvalidField
+	}
+
+	if !workflow.CanTransition(uint8(t.status), status) {
+		return errors.ErrTransitionNotAllowed
 	}
 
-	t.status = *newStatus
+	t.trackStatus(newStatus.Category, time.Now().Unix())
+	t.status = newStatus.ID
+	t.category = newStatus.Category
+	t.touch()
 
 	return nil
 }
@@ -143,17 +303,188 @@ func (t *Task) UpdatePriority(priority uint8) error {
 	}
 
 	t.priority = *newPriority
+	t.touch()
+
+	return nil
+}
+
+// UpdateEstimate sets the expected effort in minutes, 0 removes the estimate.
+func (t *Task) UpdateEstimate(minutes uint32) error {
+	newEstimate, err := valueobjects.NewTaskEstimate(minutes)
+	if err != nil {
+		return err
+	}
+
+	t.estimate = *newEstimate
+	t.touch()
+
+	return nil
+}
+
+// UpdateStoryPoints sets the relative size, 0 removes it.
+func (t *Task) UpdateStoryPoints(points uint32) error {
+	newPoints, err := valueobjects.NewTaskStoryPoints(points)
+	if err != nil {
+		return err
+	}
+
+	t.storyPoints = *newPoints
+	t.touch()
+
+	return nil
+}
+
+// Assign makes assigneeID work on the task, "" unassigns it. It returns the record
+// of the reassignment, nil if the task already had that assignee.
+func (t *Task) Assign(assigneeID, assignedBy string) *TaskAssignment {
+	if assigneeID == t.assigneeID {
+		return nil
+	}
+
+	assignment := newTaskAssignment(t.id, assignedBy, t.assigneeID, assigneeID)
+	t.assigneeID = assigneeID
+	t.touch()
+
+	return assignment
+}
+
+// UpdateTags replaces the labels of the task.
+func (t *Task) UpdateTags(tags []string) error {
+	newTags, err := valueobjects.NewTaskTags(tags)
+	if err != nil {
+		return err
+	}
+
+	t.tags = *newTags
+	t.touch()
+
+	return nil
+}
+
+// AttachTo makes the task a subtask of parent, a top-level task
+// of the same owner and workspace.
+func (t *Task) AttachTo(parent *Task) error {
+	if parent.id == t.id || parent.parentID != "" ||
+		parent.userID != t.userID || parent.workspaceID != t.workspaceID {
+		return errors.ErrInvalidField
+	}
+
+	t.parentID = parent.id
+	t.touch()
+
+	return nil
+}
+
+// UpdateRank places the task at rank in its owner's manual order.
+func (t *Task) UpdateRank(rank string) error {
+	newRank, err := valueobjects.NewTaskRank(rank)
+	if err != nil {
+		return err
+	}
+
+	t.rank = *newRank
+	t.touch()
+
+	return nil
+}
+
+// MoveBetween places the task between the tasks ranked before and after,
+// "" for the start or the end of the list.
+func (t *Task) MoveBetween(before, after string) error {
+	newRank, err := valueobjects.NewTaskRankBetween(valueobjects.TaskRank(before), valueobjects.TaskRank(after))
+	if err != nil {
+		return err
+	}
+
+	t.rank = *newRank
+	t.touch()
 
 	return nil
 }
 
-func (t *Task) UpdateDueDate(dueDate int64) error {
-	newDueDate, err := valueobjects.NewDueDate(dueDate)
+// UpdateDueDate sets the due date of an existing task. Unlike on creation the date
+// may be in the past, so overdue tasks can be saved again, and 0 clears it.
+func (t *Task) UpdateDueDate(dueDate int64, allDay bool) error {
+	newDueDate, err := valueobjects.NewChangedDueDate(dueDate, allDay)
 	if err != nil {
 		return err
 	}
 
 	t.dueDate = *newDueDate
+	t.allDay = allDay && *newDueDate != 0
+	t.touch()
+
+	return nil
+}
+
+func (t *Task) UpdateProject(project string) error {
+	p, err := valueobjects.NewTaskProject(project)
+	if err != nil {
+		return err
+	}
+
+	t.project = *p
+	t.touch()
 
 	return nil
 }
+
+// NewImportedTask is NewTask for tasks brought in by an import,
+// which keep their history: their due dates may be past.
+func NewImportedTask(userID, workspaceID, title, description string,
+	status, priority uint8, dueDate int64, allDay bool, workflow *Workflow) (*Task, error) {
+	t, err := valueobjects.NewTaskTitle(title)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := valueobjects.NewDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := workflow.Status(status)
+	if !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	p, err := valueobjects.NewTaskPriority(priority)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := valueobjects.NewChangedDueDate(dueDate, allDay)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+
+	task := NewTaskFromStorage(uuid.New().String(), userID, workspaceID, "", "", string(*t), string(*d),
+		uint8(s.ID), uint8(s.Category), uint8(*p), int64(*dd), allDay, "", 0, 0, "", "", now, now, 0, 0)
+	task.trackStatus(s.Category, now)
+
+	return task, nil
+}
+
+// IsOverdue reports whether the open task is past its due date at now.
+// All-day tasks are overdue once their date is over in loc, the user's location.
+func (t *Task) IsOverdue(now time.Time, loc *time.Location) bool {
+	if t.dueDate == 0 || t.category == valueobjects.StatusCategoryDone {
+		return false
+	}
+
+	if t.allDay {
+		return valueobjects.DateOf(now.In(loc)) > int64(t.dueDate)
+	}
+
+	return now.Unix() > int64(t.dueDate)
+}
+
+func newDueDate(dueDate int64, allDay bool, loc *time.Location) (*valueobjects.TaskDueDate, error) {
+	if allDay {
+		return valueobjects.NewAllDayDueDate(dueDate, loc)
+	}
+
+	return valueobjects.NewDueDate(dueDate)
+}
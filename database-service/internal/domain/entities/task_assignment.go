@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskAssignment records that a task changed hands, so the people involved can be notified.
+// Empty from or to IDs mean the task was unassigned before or after.
+type TaskAssignment struct {
+	id         string
+	taskID     string
+	assignedBy string
+	fromUserID string
+	toUserID   string
+	createdAt  int64
+}
+
+func newTaskAssignment(taskID, assignedBy, fromUserID, toUserID string) *TaskAssignment {
+	return &TaskAssignment{
+		id:         uuid.New().String(),
+		taskID:     taskID,
+		assignedBy: assignedBy,
+		fromUserID: fromUserID,
+		toUserID:   toUserID,
+		createdAt:  time.Now().Unix(),
+	}
+}
+
+func (a *TaskAssignment) ID() string {
+	return a.id
+}
+
+func (a *TaskAssignment) TaskID() string {
+	return a.taskID
+}
+
+func (a *TaskAssignment) AssignedBy() string {
+	return a.assignedBy
+}
+
+func (a *TaskAssignment) FromUserID() string {
+	return a.fromUserID
+}
+
+func (a *TaskAssignment) ToUserID() string {
+	return a.toUserID
+}
+
+func (a *TaskAssignment) CreatedAt() int64 {
+	return a.createdAt
+}
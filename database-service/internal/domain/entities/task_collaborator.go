@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"time"
+
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+)
+
+// TaskCollaborator is a user a task is shared with and the role they have on it.
+type TaskCollaborator struct {
+	taskID    string
+	userID    string
+	username  string
+	role      valueobjects.TaskRole
+	createdAt int64
+}
+
+func NewTaskCollaborator(taskID, userID, username string, role uint8) (*TaskCollaborator, error) {
+	r, err := valueobjects.NewTaskRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskCollaborator{
+		taskID:    taskID,
+		userID:    userID,
+		username:  username,
+		role:      *r,
+		createdAt: time.Now().Unix(),
+	}, nil
+}
+
+func NewTaskCollaboratorFromStorage(taskID, userID, username string, role uint8, createdAt int64) *TaskCollaborator {
+	return &TaskCollaborator{
+		taskID:    taskID,
+		userID:    userID,
+		username:  username,
+		role:      valueobjects.TaskRole(role),
+		createdAt: createdAt,
+	}
+}
+
+func (c *TaskCollaborator) TaskID() string {
+	return c.taskID
+}
+
+func (c *TaskCollaborator) UserID() string {
+	return c.userID
+}
+
+func (c *TaskCollaborator) Username() string {
+	return c.username
+}
+
+func (c *TaskCollaborator) Role() valueobjects.TaskRole {
+	return c.role
+}
+
+func (c *TaskCollaborator) CreatedAt() int64 {
+	return c.createdAt
+}
@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// TaskOperation names what a command did, so clients can tell the user what was undone.
+type TaskOperation string
+
+const (
+	TaskOperationCreate     TaskOperation = "create"
+	TaskOperationUpdate     TaskOperation = "update"
+	TaskOperationDelete     TaskOperation = "delete"
+	TaskOperationBulkUpdate TaskOperation = "bulk_update"
+	TaskOperationImport     TaskOperation = "import"
+	TaskOperationAssign     TaskOperation = "assign"
+	TaskOperationMove       TaskOperation = "move"
+)
+
+// TaskChange is the state of a task before and after a command, nil where the task doesn't exist.
+type TaskChange struct {
+	before *Task
+	after  *Task
+}
+
+func NewTaskChange(before, after *Task) TaskChange {
+	return TaskChange{
+		before: before,
+		after:  after,
+	}
+}
+
+func (c TaskChange) Before() *Task {
+	return c.before
+}
+
+func (c TaskChange) After() *Task {
+	return c.after
+}
+
+func (c TaskChange) TaskID() string {
+	if c.after != nil {
+		return c.after.ID()
+	}
+
+	return c.before.ID()
+}
+
+// TaskCommand is an entry of a user's undo log in a workspace. Instead of the inverse
+// operation it keeps the states of the tasks it changed, undoing it restores the states
+// before and redoing it the states after, as long as the tasks weren't changed since.
+type TaskCommand struct {
+	id          string
+	userID      string
+	workspaceID string
+	operation   TaskOperation
+	changes     []TaskChange
+	undone      bool
+	createdAt   int64 // in nanoseconds, so the commands of a user never tie
+}
+
+func NewTaskCommand(userID, workspaceID string, operation TaskOperation, changes []TaskChange) (*TaskCommand, error) {
+	if len(changes) == 0 {
+		return nil, errors.ErrEmptyField
+	}
+
+	for _, c := range changes {
+		if c.before == nil && c.after == nil {
+			return nil, errors.ErrInvalidField
+		}
+	}
+
+	return &TaskCommand{
+		id:          uuid.New().String(),
+		userID:      userID,
+		workspaceID: workspaceID,
+		operation:   operation,
+		changes:     changes,
+		createdAt:   time.Now().UnixNano(),
+	}, nil
+}
+
+func NewTaskCommandFromStorage(id, userID, workspaceID, operation string, changes []TaskChange,
+	undone bool, createdAt int64) *TaskCommand {
+	return &TaskCommand{
+		id:          id,
+		userID:      userID,
+		workspaceID: workspaceID,
+		operation:   TaskOperation(operation),
+		changes:     changes,
+		undone:      undone,
+		createdAt:   createdAt,
+	}
+}
+
+func (c *TaskCommand) ID() string {
+	return c.id
+}
+
+func (c *TaskCommand) UserID() string {
+	return c.userID
+}
+
+func (c *TaskCommand) WorkspaceID() string {
+	return c.workspaceID
+}
+
+func (c *TaskCommand) Operation() string {
+	return string(c.operation)
+}
+
+func (c *TaskCommand) Changes() []TaskChange {
+	return c.changes
+}
+
+func (c *TaskCommand) Undone() bool {
+	return c.undone
+}
+
+func (c *TaskCommand) CreatedAt() int64 {
+	return c.createdAt
+}
+
+func (c *TaskCommand) Undo() error {
+	if c.undone {
+		return errors.ErrInvalidField
+	}
+
+	c.undone = true
+	return nil
+}
+
+func (c *TaskCommand) Redo() error {
+	if !c.undone {
+		return errors.ErrInvalidField
+	}
+
+	c.undone = false
+	return nil
+}
+
+// Steps returns the changes that bring the tasks to the command's current side:
+// back to the states before it once it's undone, to the states after it once it's redone.
+func (c *TaskCommand) Steps() []TaskChange {
+	if !c.undone {
+		return c.changes
+	}
+
+	steps := make([]TaskChange, 0, len(c.changes))
+	for _, change := range c.changes {
+		steps = append(steps, NewTaskChange(change.after, change.before))
+	}
+
+	return steps
+}
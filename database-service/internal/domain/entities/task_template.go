@@ -0,0 +1,241 @@
+package entities
+
+import (
+	"maps"
+	"time"
+
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/template"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const maxTemplateSubtasks = 50
+
+// TaskTemplateItem is a task of a template before its placeholders are filled in.
+type TaskTemplateItem struct {
+	title       valueobjects.TemplateText
+	description valueobjects.TemplateText
+	priority    taskvo.TaskPriority
+	dueOffset   valueobjects.DueOffset
+}
+
+// NewTaskTemplateItem checks the texts like a task's title and description,
+// filled in values can still make them too long.
+func NewTaskTemplateItem(title, description string, priority uint8, dueOffset string) (*TaskTemplateItem, error) {
+	if _, err := taskvo.NewTaskTitle(title); err != nil {
+		return nil, err
+	}
+
+	if _, err := taskvo.NewDescription(description); err != nil {
+		return nil, err
+	}
+
+	t, err := valueobjects.NewTemplateText(title)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := valueobjects.NewTemplateText(description)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := taskvo.NewTaskPriority(priority)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := valueobjects.NewDueOffset(dueOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskTemplateItem{
+		title:       *t,
+		description: *d,
+		priority:    *p,
+		dueOffset:   *o,
+	}, nil
+}
+
+func NewTaskTemplateItemFromStorage(title, description string, priority uint8, dueOffset string) TaskTemplateItem {
+	return TaskTemplateItem{
+		title:       valueobjects.TemplateText(title),
+		description: valueobjects.TemplateText(description),
+		priority:    taskvo.TaskPriority(priority),
+		dueOffset:   valueobjects.DueOffset(dueOffset),
+	}
+}
+
+func (i TaskTemplateItem) Title() string {
+	return string(i.title)
+}
+
+func (i TaskTemplateItem) Description() string {
+	return string(i.description)
+}
+
+func (i TaskTemplateItem) Priority() uint8 {
+	return uint8(i.priority)
+}
+
+func (i TaskTemplateItem) DueOffset() string {
+	return string(i.dueOffset)
+}
+
+// TaskTemplate is a recurring set of tasks, like a checklist, the user instantiates
+// into a task with subtasks. The tags go on every task it makes.
+type TaskTemplate struct {
+	id        string
+	userID    string
+	name      valueobjects.TemplateName
+	task      TaskTemplateItem
+	tags      taskvo.TaskTags
+	subtasks  []TaskTemplateItem
+	createdAt int64
+	updatedAt int64
+}
+
+func NewTaskTemplate(userID, name string, task TaskTemplateItem, tags []string, subtasks []TaskTemplateItem) (*TaskTemplate, error) {
+	now := time.Now().Unix()
+	template := &TaskTemplate{
+		id:        uuid.New().String(),
+		userID:    userID,
+		createdAt: now,
+	}
+
+	if err := template.Update(name, task, tags, subtasks); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func NewTaskTemplateFromStorage(id, userID, name string, task TaskTemplateItem, tags string,
+	subtasks []TaskTemplateItem, createdAt, updatedAt int64) *TaskTemplate {
+	return &TaskTemplate{
+		id:        id,
+		userID:    userID,
+		name:      valueobjects.TemplateName(name),
+		task:      task,
+		tags:      taskvo.TaskTags(tags),
+		subtasks:  subtasks,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (t *TaskTemplate) ID() string {
+	return t.id
+}
+
+func (t *TaskTemplate) UserID() string {
+	return t.userID
+}
+
+func (t *TaskTemplate) Name() string {
+	return string(t.name)
+}
+
+func (t *TaskTemplate) Task() TaskTemplateItem {
+	return t.task
+}
+
+func (t *TaskTemplate) Tags() []string {
+	return t.tags.List()
+}
+
+func (t *TaskTemplate) Subtasks() []TaskTemplateItem {
+	return t.subtasks
+}
+
+func (t *TaskTemplate) CreatedAt() int64 {
+	return t.createdAt
+}
+
+func (t *TaskTemplate) UpdatedAt() int64 {
+	return t.updatedAt
+}
+
+// Update replaces everything the template captures.
+func (t *TaskTemplate) Update(name string, task TaskTemplateItem, tags []string, subtasks []TaskTemplateItem) error {
+	n, err := valueobjects.NewTemplateName(name)
+	if err != nil {
+		return err
+	}
+
+	tg, err := taskvo.NewTaskTags(tags)
+	if err != nil {
+		return err
+	}
+
+	if len(subtasks) > maxTemplateSubtasks {
+		return errors.ErrInvalidField
+	}
+
+	t.name = *n
+	t.task = task
+	t.tags = *tg
+	t.subtasks = subtasks
+	t.updatedAt = time.Now().Unix()
+
+	return nil
+}
+
+// Instantiate makes the template's task and its subtasks in the workspace at now, the task first.
+// Placeholders are filled in from vars, {{date}} is the date of now in loc.
+func (t *TaskTemplate) Instantiate(workspaceID string, vars map[string]string,
+	workflow *Workflow, now time.Time, loc *time.Location) ([]*Task, error) {
+	values := maps.Clone(vars)
+	if values == nil {
+		values = make(map[string]string, 1)
+	}
+	values[valueobjects.DateVariable] = now.In(loc).Format(time.DateOnly)
+
+	parent, err := t.instantiateItem(t.task, workspaceID, values, workflow, now, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*Task{parent}
+	for _, item := range t.subtasks {
+		task, err := t.instantiateItem(item, workspaceID, values, workflow, now, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := task.AttachTo(parent); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+func (t *TaskTemplate) instantiateItem(item TaskTemplateItem, workspaceID string, vars map[string]string,
+	workflow *Workflow, now time.Time, loc *time.Location) (*Task, error) {
+	title, err := item.title.Render(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := item.description.Render(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	dueDate, allDay := item.dueOffset.DueDate(now, loc)
+	task, err := NewTask(t.userID, workspaceID, title, description,
+		uint8(workflow.InitialStatus().ID), uint8(item.priority), dueDate, allDay, workflow, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := task.UpdateTags(t.tags.List()); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
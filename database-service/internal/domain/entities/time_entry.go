@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	maxTimeEntryProject  = 64
+	maxTimeEntryDuration = 24 * time.Hour
+)
+
+// TimeEntry is time spent on a task. A running timer is an entry without a stop time,
+// the project is a free-form label time is billed to.
+type TimeEntry struct {
+	id        string
+	userID    string
+	taskID    string
+	project   string
+	startedAt int64
+	stoppedAt int64
+}
+
+// StartTimeEntry starts a timer on the task now.
+func StartTimeEntry(userID, taskID, project string) (*TimeEntry, error) {
+	p, err := newTimeEntryProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeEntry{
+		id:        uuid.New().String(),
+		userID:    userID,
+		taskID:    taskID,
+		project:   p,
+		startedAt: time.Now().Unix(),
+	}, nil
+}
+
+// NewTimeEntry is a manually added entry, it must have ended and last at most a day.
+func NewTimeEntry(userID, taskID, project string, startedAt, stoppedAt int64) (*TimeEntry, error) {
+	p, err := newTimeEntryProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt <= 0 || stoppedAt <= startedAt ||
+		stoppedAt > time.Now().Unix() ||
+		time.Duration(stoppedAt-startedAt)*time.Second > maxTimeEntryDuration {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &TimeEntry{
+		id:        uuid.New().String(),
+		userID:    userID,
+		taskID:    taskID,
+		project:   p,
+		startedAt: startedAt,
+		stoppedAt: stoppedAt,
+	}, nil
+}
+
+func NewTimeEntryFromStorage(id, userID, taskID, project string, startedAt, stoppedAt int64) *TimeEntry {
+	return &TimeEntry{
+		id:        id,
+		userID:    userID,
+		taskID:    taskID,
+		project:   project,
+		startedAt: startedAt,
+		stoppedAt: stoppedAt,
+	}
+}
+
+func newTimeEntryProject(project string) (string, error) {
+	project = strings.TrimSpace(project)
+	if utf8.RuneCountInString(project) > maxTimeEntryProject {
+		return "", errors.ErrTooLongField
+	}
+
+	return project, nil
+}
+
+func (e *TimeEntry) ID() string {
+	return e.id
+}
+
+func (e *TimeEntry) UserID() string {
+	return e.userID
+}
+
+func (e *TimeEntry) TaskID() string {
+	return e.taskID
+}
+
+func (e *TimeEntry) Project() string {
+	return e.project
+}
+
+func (e *TimeEntry) StartedAt() int64 {
+	return e.startedAt
+}
+
+// StoppedAt returns when the timer was stopped, 0 while it's running.
+func (e *TimeEntry) StoppedAt() int64 {
+	return e.stoppedAt
+}
+
+func (e *TimeEntry) IsRunning() bool {
+	return e.stoppedAt == 0
+}
+
+// Stop stops the running timer now.
+func (e *TimeEntry) Stop() error {
+	if !e.IsRunning() {
+		return errors.ErrTimerNotRunning
+	}
+
+	e.stoppedAt = max(time.Now().Unix(), e.startedAt)
+
+	return nil
+}
+
+// Seconds returns the tracked time, a running timer counts until now.
+func (e *TimeEntry) Seconds(now time.Time) int64 {
+	if e.IsRunning() {
+		return max(now.Unix()-e.startedAt, 0)
+	}
+
+	return e.stoppedAt - e.startedAt
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"time"
 
 	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/user"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ type User struct {
 	id           string
 	username     valueobjects.Username
 	passwordHash []byte
+	timezone     valueobjects.Timezone
 }
 
 func NewUser(username, password string) (*User, error) {
@@ -32,11 +34,12 @@ func NewUser(username, password string) (*User, error) {
 	}, nil
 }
 
-func NewUserFromStorage(id, username string, passwordHash []byte) *User {
+func NewUserFromStorage(id, username string, passwordHash []byte, timezone string) *User {
 	return &User{
 		id:           id,
 		username:     valueobjects.Username(username),
 		passwordHash: passwordHash,
+		timezone:     valueobjects.Timezone(timezone),
 	}
 }
 
@@ -51,3 +54,28 @@ func (u *User) Username() string {
 func (u *User) PasswordHash() []byte {
 	return u.passwordHash
 }
+
+// CheckPassword reports whether password is the user's.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword(u.passwordHash, []byte(password)) == nil
+}
+
+func (u *User) Timezone() string {
+	return string(u.timezone)
+}
+
+// Location returns where the user's days start and end, UTC if no timezone is set.
+func (u *User) Location() *time.Location {
+	return u.timezone.Location()
+}
+
+func (u *User) UpdateTimezone(timezone string) error {
+	tz, err := valueobjects.NewTimezone(timezone)
+	if err != nil {
+		return err
+	}
+
+	u.timezone = *tz
+
+	return nil
+}
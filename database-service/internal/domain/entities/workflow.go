@@ -0,0 +1,162 @@
+package entities
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+const (
+	maxWorkflowStatuses   = 16
+	maxWorkflowStatusName = 32
+)
+
+type WorkflowStatus struct {
+	ID       valueobjects.TaskStatus
+	Name     string
+	Category valueobjects.StatusCategory
+}
+
+type WorkflowTransition struct {
+	From valueobjects.TaskStatus
+	To   valueobjects.TaskStatus
+}
+
+// Workflow is the user's ordered list of statuses and the moves allowed between them.
+// The first status is the one new tasks start in. Without transitions any move is allowed.
+type Workflow struct {
+	userID      string
+	statuses    []WorkflowStatus
+	transitions []WorkflowTransition
+	isDefault   bool
+}
+
+func NewWorkflow(userID string, statuses []WorkflowStatus, transitions []WorkflowTransition) (*Workflow, error) {
+	if len(statuses) == 0 {
+		return nil, errors.ErrEmptyField
+	}
+
+	if len(statuses) > maxWorkflowStatuses {
+		return nil, errors.ErrTooLongField
+	}
+
+	ids := make(map[valueobjects.TaskStatus]bool, len(statuses))
+	names := make(map[string]bool, len(statuses))
+	for i, status := range statuses {
+		status.Name = strings.TrimSpace(status.Name)
+		if status.Name == "" {
+			return nil, errors.ErrEmptyField
+		}
+
+		if utf8.RuneCountInString(status.Name) > maxWorkflowStatusName {
+			return nil, errors.ErrTooLongField
+		}
+
+		name := strings.ToLower(status.Name)
+		if ids[status.ID] || names[name] || !status.Category.IsValid() {
+			return nil, errors.ErrInvalidField
+		}
+
+		ids[status.ID] = true
+		names[name] = true
+		statuses[i] = status
+	}
+
+	seen := make(map[WorkflowTransition]bool, len(transitions))
+	for _, transition := range transitions {
+		if !ids[transition.From] || !ids[transition.To] ||
+			transition.From == transition.To || seen[transition] {
+			return nil, errors.ErrInvalidField
+		}
+		seen[transition] = true
+	}
+
+	return &Workflow{
+		userID:      userID,
+		statuses:    statuses,
+		transitions: transitions,
+	}, nil
+}
+
+func NewWorkflowFromStorage(userID string, statuses []WorkflowStatus, transitions []WorkflowTransition) *Workflow {
+	return &Workflow{
+		userID:      userID,
+		statuses:    statuses,
+		transitions: transitions,
+	}
+}
+
+// DefaultWorkflow is used by users without their own workflow.
+// Its statuses are the todo, in progress and done statuses tasks had before workflows.
+func DefaultWorkflow(userID string) *Workflow {
+	return &Workflow{
+		userID: userID,
+		statuses: []WorkflowStatus{
+			{ID: valueobjects.TaskStatusTodo, Name: "todo", Category: valueobjects.StatusCategoryTodo},
+			{ID: valueobjects.TaskStatusInProgress, Name: "in progress", Category: valueobjects.StatusCategoryActive},
+			{ID: valueobjects.TaskStatusDone, Name: "done", Category: valueobjects.StatusCategoryDone},
+		},
+		isDefault: true,
+	}
+}
+
+func (w *Workflow) UserID() string {
+	return w.userID
+}
+
+func (w *Workflow) Statuses() []WorkflowStatus {
+	return w.statuses
+}
+
+func (w *Workflow) Transitions() []WorkflowTransition {
+	return w.transitions
+}
+
+func (w *Workflow) IsDefault() bool {
+	return w.isDefault
+}
+
+func (w *Workflow) InitialStatus() WorkflowStatus {
+	return w.statuses[0]
+}
+
+func (w *Workflow) Status(id uint8) (WorkflowStatus, bool) {
+	for _, status := range w.statuses {
+		if status.ID == valueobjects.TaskStatus(id) {
+			return status, true
+		}
+	}
+
+	return WorkflowStatus{}, false
+}
+
+// StatusByName finds a status by its name, ignoring case and
+// treating "_" as a space, so "in_progress" matches "In progress".
+func (w *Workflow) StatusByName(name string) (WorkflowStatus, bool) {
+	name = strings.ReplaceAll(strings.TrimSpace(name), "_", " ")
+	for _, status := range w.statuses {
+		if strings.EqualFold(status.Name, name) {
+			return status, true
+		}
+	}
+
+	return WorkflowStatus{}, false
+}
+
+// CanTransition reports whether a task can move between the statuses.
+// Staying in the same status is always allowed.
+func (w *Workflow) CanTransition(from, to uint8) bool {
+	if from == to || len(w.transitions) == 0 {
+		return true
+	}
+
+	for _, transition := range w.transitions {
+		if transition.From == valueobjects.TaskStatus(from) && transition.To == valueobjects.TaskStatus(to) {
+			return true
+		}
+	}
+
+	return false
+}
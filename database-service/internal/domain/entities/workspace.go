@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"time"
+
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/workspace"
+	"github.com/google/uuid"
+)
+
+// Workspace isolates the tasks of a team. Every user also has a personal workspace
+// that has the same ID as the user and that no one else can join.
+type Workspace struct {
+	id        string
+	name      valueobjects.WorkspaceName
+	createdAt int64
+}
+
+func NewWorkspace(name string) (*Workspace, error) {
+	n, err := valueobjects.NewWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Workspace{
+		id:        uuid.New().String(),
+		name:      *n,
+		createdAt: time.Now().Unix(),
+	}, nil
+}
+
+// NewPersonalWorkspace returns the personal workspace of the user, named after them.
+func NewPersonalWorkspace(user *User) *Workspace {
+	return &Workspace{
+		id:        user.ID(),
+		name:      valueobjects.WorkspaceName(user.Username()),
+		createdAt: time.Now().Unix(),
+	}
+}
+
+func NewWorkspaceFromStorage(id, name string, createdAt int64) *Workspace {
+	return &Workspace{
+		id:        id,
+		name:      valueobjects.WorkspaceName(name),
+		createdAt: createdAt,
+	}
+}
+
+func (w *Workspace) ID() string {
+	return w.id
+}
+
+func (w *Workspace) Name() string {
+	return string(w.name)
+}
+
+func (w *Workspace) CreatedAt() int64 {
+	return w.createdAt
+}
+
+// IsPersonalOf reports whether the workspace is the user's personal workspace.
+func (w *Workspace) IsPersonalOf(userID string) bool {
+	return w.id == userID
+}
+
+// Membership is a user's role in a workspace.
+type Membership struct {
+	workspaceID   string
+	workspaceName string
+	userID        string
+	role          valueobjects.WorkspaceRole
+	createdAt     int64
+}
+
+func NewMembership(workspace *Workspace, userID string, role uint8) (*Membership, error) {
+	r, err := valueobjects.NewWorkspaceRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Membership{
+		workspaceID:   workspace.ID(),
+		workspaceName: workspace.Name(),
+		userID:        userID,
+		role:          *r,
+		createdAt:     time.Now().Unix(),
+	}, nil
+}
+
+func NewMembershipFromStorage(workspaceID, workspaceName, userID string, role uint8, createdAt int64) *Membership {
+	return &Membership{
+		workspaceID:   workspaceID,
+		workspaceName: workspaceName,
+		userID:        userID,
+		role:          valueobjects.WorkspaceRole(role),
+		createdAt:     createdAt,
+	}
+}
+
+func (m *Membership) WorkspaceID() string {
+	return m.workspaceID
+}
+
+func (m *Membership) WorkspaceName() string {
+	return m.workspaceName
+}
+
+func (m *Membership) UserID() string {
+	return m.userID
+}
+
+func (m *Membership) Role() valueobjects.WorkspaceRole {
+	return m.role
+}
+
+func (m *Membership) CreatedAt() int64 {
+	return m.createdAt
+}
+
+// IsPersonal reports whether the membership is in the user's personal workspace.
+func (m *Membership) IsPersonal() bool {
+	return m.workspaceID == m.userID
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"time"
+
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/workspace"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// WorkspaceInvitation is a pending invitation of a user to a workspace.
+// The user becomes a member with the invited role once they accept it.
+type WorkspaceInvitation struct {
+	id            string
+	workspaceID   string
+	workspaceName string
+	userID        string
+	invitedBy     string
+	role          valueobjects.WorkspaceRole
+	createdAt     int64
+}
+
+// NewWorkspaceInvitation invites as a member or an admin, workspaces have a single owner.
+func NewWorkspaceInvitation(workspace *Workspace, userID, invitedBy string, role uint8) (*WorkspaceInvitation, error) {
+	r, err := valueobjects.NewWorkspaceRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	if *r == valueobjects.WorkspaceRoleOwner {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &WorkspaceInvitation{
+		id:            uuid.New().String(),
+		workspaceID:   workspace.ID(),
+		workspaceName: workspace.Name(),
+		userID:        userID,
+		invitedBy:     invitedBy,
+		role:          *r,
+		createdAt:     time.Now().Unix(),
+	}, nil
+}
+
+func NewWorkspaceInvitationFromStorage(id, workspaceID, workspaceName, userID, invitedBy string,
+	role uint8, createdAt int64) *WorkspaceInvitation {
+	return &WorkspaceInvitation{
+		id:            id,
+		workspaceID:   workspaceID,
+		workspaceName: workspaceName,
+		userID:        userID,
+		invitedBy:     invitedBy,
+		role:          valueobjects.WorkspaceRole(role),
+		createdAt:     createdAt,
+	}
+}
+
+func (i *WorkspaceInvitation) ID() string {
+	return i.id
+}
+
+func (i *WorkspaceInvitation) WorkspaceID() string {
+	return i.workspaceID
+}
+
+func (i *WorkspaceInvitation) WorkspaceName() string {
+	return i.workspaceName
+}
+
+func (i *WorkspaceInvitation) UserID() string {
+	return i.userID
+}
+
+func (i *WorkspaceInvitation) InvitedBy() string {
+	return i.invitedBy
+}
+
+func (i *WorkspaceInvitation) Role() valueobjects.WorkspaceRole {
+	return i.role
+}
+
+func (i *WorkspaceInvitation) CreatedAt() int64 {
+	return i.createdAt
+}
+
+// Accept makes the invited user a member of the workspace.
+func (i *WorkspaceInvitation) Accept() *Membership {
+	return &Membership{
+		workspaceID:   i.workspaceID,
+		workspaceName: i.workspaceName,
+		userID:        i.userID,
+		role:          i.role,
+		createdAt:     time.Now().Unix(),
+	}
+}
@@ -2,6 +2,7 @@ package valueobjects
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/braunkc/todo-app/database-service/pkg/errors"
 	"github.com/google/uuid"
@@ -15,6 +16,16 @@ const (
 	TaskStatusDone
 )
 
+// StatusCategory is what a workflow status means for the task, queries
+// use it instead of the status to tell open tasks from finished ones.
+type StatusCategory uint8
+
+const (
+	StatusCategoryTodo StatusCategory = iota
+	StatusCategoryActive
+	StatusCategoryDone
+)
+
 type TaskPriority uint8
 
 const (
@@ -26,11 +37,22 @@ const (
 type SortField string
 
 const (
-	SortByPriority  SortField = "priority"
-	SortByDueDate   SortField = "due_date"
-	SortByCreatedAt SortField = "created_at"
+	SortByPriority    SortField = "priority"
+	SortByDueDate     SortField = "due_date"
+	SortByCreatedAt   SortField = "created_at"
+	SortByTitle       SortField = "title"
+	SortByStatus      SortField = "status"
+	SortByUpdatedAt   SortField = "updated_at"
+	SortByCompletedAt SortField = "completed_at"
+	SortByEstimate    SortField = "estimate_minutes"
+	SortByStoryPoints SortField = "story_points"
+	// SortByManual orders tasks the way their owner arranged them.
+	SortByManual SortField = "rank"
 )
 
+// MaxSortKeys limits the number of sort keys in a query.
+const MaxSortKeys = 5
+
 type SortDirection string
 
 const (
@@ -38,28 +60,58 @@ const (
 	SortDesc SortDirection = "DESC"
 )
 
+type NullsOrder string
+
+const (
+	NullsLast  NullsOrder = "LAST"
+	NullsFirst NullsOrder = "FIRST"
+)
+
 type TaskFilters struct {
-	Statuses   []TaskStatus
-	Priorities []TaskPriority
+	Statuses      []TaskStatus
+	Priorities    []TaskPriority
+	DueBefore     *time.Time
+	DueAfter      *time.Time
+	CreatedBefore *time.Time
+	CreatedAfter  *time.Time
+	OverdueOnly   bool
+	HasDueDate    *bool
+	HasEstimate   *bool
+	// MaxEstimate matches estimated tasks expected to take at most that many minutes, 0 for no limit.
+	MaxEstimate uint32
+	StoryPoints []uint32
+	// SharedWithMe selects the tasks other users shared with the user instead of the user's own.
+	SharedWithMe bool
+	// AssignedToMe selects the tasks assigned to the user instead of the user's own,
+	// together with the shared ones if SharedWithMe is also set.
+	AssignedToMe bool
+	// AssigneeID matches tasks assigned to that user.
+	AssigneeID string
+	// Location is where the dates of all-day tasks are compared with the
+	// bounds and where today ends for overdue tasks, UTC if nil.
+	Location *time.Location
 }
 
 type TaskOrderBy struct {
 	Field     SortField
 	Direction SortDirection
+	Nulls     NullsOrder
 }
 
 type GetTasksQuery struct {
-	userID     string
-	pageSize   int64
-	pageNumber int64
-	orderBy    TaskOrderBy
-	filters    TaskFilters
-	title      string
+	userID      string
+	workspaceID string
+	pageSize    int64
+	pageNumber  int64
+	orderBy     []TaskOrderBy
+	filters     TaskFilters
+	title       string
 }
 
-func NewGetTasksQuery(userID string, pageSize, pageNumber int64,
-	sortField SortField, sortDirection SortDirection,
-	statuses []TaskStatus, priorities []TaskPriority, title string) (*GetTasksQuery, error) {
+// NewGetTasksQuery builds a query sorted by orderBy keys in order of precedence,
+// by priority if there are none.
+func NewGetTasksQuery(userID, workspaceID string, pageSize, pageNumber int64,
+	orderBy []TaskOrderBy, filters TaskFilters, title string) (*GetTasksQuery, error) {
 	if pageSize < 1 || pageSize > 1000 {
 		pageSize = 10
 	}
@@ -68,12 +120,25 @@ func NewGetTasksQuery(userID string, pageSize, pageNumber int64,
 		pageNumber = 1
 	}
 
-	if sortField == "" {
-		sortField = "priority"
+	if len(orderBy) == 0 {
+		orderBy = []TaskOrderBy{{Field: SortByPriority}}
 	}
 
-	if sortDirection == "" {
-		sortDirection = "ASC"
+	keys := make([]TaskOrderBy, 0, len(orderBy))
+	for _, key := range orderBy {
+		if key.Field == "" {
+			key.Field = SortByPriority
+		}
+
+		if key.Direction == "" {
+			key.Direction = SortAsc
+		}
+
+		if key.Nulls == "" {
+			key.Nulls = NullsLast
+		}
+
+		keys = append(keys, key)
 	}
 
 	if len(title) > 255 {
@@ -81,18 +146,13 @@ func NewGetTasksQuery(userID string, pageSize, pageNumber int64,
 	}
 
 	query := GetTasksQuery{
-		userID:     userID,
-		pageSize:   pageSize,
-		pageNumber: pageNumber,
-		orderBy: TaskOrderBy{
-			Field:     sortField,
-			Direction: sortDirection,
-		},
-		filters: TaskFilters{
-			Statuses:   statuses,
-			Priorities: priorities,
-		},
-		title: title,
+		userID:      userID,
+		workspaceID: workspaceID,
+		pageSize:    pageSize,
+		pageNumber:  pageNumber,
+		orderBy:     keys,
+		filters:     filters,
+		title:       title,
 	}
 
 	if err := query.Validate(); err != nil {
@@ -108,35 +168,46 @@ func (q GetTasksQuery) Validate() error {
 		return errors.ErrInvalidField
 	}
 
-	if !q.isValidSortField() {
-		fmt.Println("field")
+	if _, err := uuid.Parse(q.workspaceID); err != nil {
 		return errors.ErrInvalidField
 	}
 
-	if !q.isValidSortDirection() {
-		fmt.Println("dir")
+	if len(q.orderBy) > MaxSortKeys {
 		return errors.ErrInvalidField
 	}
 
+	seen := make(map[SortField]bool, len(q.orderBy))
+	for _, key := range q.orderBy {
+		if !key.isValid() || seen[key.Field] {
+			return errors.ErrInvalidField
+		}
+		seen[key.Field] = true
+	}
+
+	if err := q.filters.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (q GetTasksQuery) isValidSortField() bool {
-	if q.orderBy.Field == "" {
-		fmt.Println("EMPTY FIELD")
+func (o TaskOrderBy) isValid() bool {
+	switch o.Field {
+	case SortByPriority, SortByDueDate, SortByCreatedAt,
+		SortByTitle, SortByStatus, SortByUpdatedAt, SortByCompletedAt,
+		SortByEstimate, SortByStoryPoints, SortByManual:
+	default:
+		return false
 	}
 
-	switch q.orderBy.Field {
-	case "", SortByPriority, SortByDueDate, SortByCreatedAt:
-		return true
+	switch o.Direction {
+	case SortAsc, SortDesc:
 	default:
 		return false
 	}
-}
 
-func (q GetTasksQuery) isValidSortDirection() bool {
-	switch q.orderBy.Direction {
-	case SortAsc, SortDesc:
+	switch o.Nulls {
+	case NullsLast, NullsFirst:
 		return true
 	default:
 		return false
@@ -147,6 +218,10 @@ func (q *GetTasksQuery) UserID() string {
 	return q.userID
 }
 
+func (q *GetTasksQuery) WorkspaceID() string {
+	return q.workspaceID
+}
+
 func (q *GetTasksQuery) PageSize() int64 {
 	return q.pageSize
 }
@@ -163,6 +238,6 @@ func (q *GetTasksQuery) Filters() TaskFilters {
 	return q.filters
 }
 
-func (q *GetTasksQuery) OrderBy() TaskOrderBy {
+func (q *GetTasksQuery) OrderBy() []TaskOrderBy {
 	return q.orderBy
 }
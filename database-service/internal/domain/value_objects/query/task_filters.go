@@ -0,0 +1,78 @@
+package valueobjects
+
+import (
+	"strings"
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// LoadTimezone returns the location for an IANA timezone name, UTC if the name is empty.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	return loc, nil
+}
+
+// DateOf returns the calendar date of t as the unix time of its midnight UTC,
+// the way all-day due dates are stored.
+func DateOf(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// ParseDateBound parses a filter bound given as a date (YYYY-MM-DD) or an RFC3339 time.
+// A date is the start of that day in loc, or its last second if upper is true,
+// so both bounds of a date range include the named days.
+func ParseDateBound(value string, loc *time.Location, upper bool) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, value, loc)
+	if err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	if upper {
+		day = day.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	return &day, nil
+}
+
+func (f TaskFilters) Validate() error {
+	if f.DueAfter != nil && f.DueBefore != nil && f.DueAfter.After(*f.DueBefore) {
+		return errors.ErrInvalidField
+	}
+
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && f.CreatedAfter.After(*f.CreatedBefore) {
+		return errors.ErrInvalidField
+	}
+
+	// tasks without a due date can't match due date bounds or be overdue
+	if f.HasDueDate != nil && !*f.HasDueDate &&
+		(f.OverdueOnly || f.DueAfter != nil || f.DueBefore != nil) {
+		return errors.ErrInvalidField
+	}
+
+	if f.AssigneeID != "" {
+		if _, err := uuid.Parse(f.AssigneeID); err != nil {
+			return errors.ErrInvalidField
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package valueobjects
+
+import (
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type StatsBucket string
+
+const (
+	StatsBucketDay  StatsBucket = "day"
+	StatsBucketWeek StatsBucket = "week"
+)
+
+const (
+	defaultStatsRange = 30 * 24 * time.Hour
+	maxStatsRange     = 366 * 24 * time.Hour
+)
+
+type TaskStatsQuery struct {
+	userID      string
+	workspaceID string
+	from        time.Time
+	to          time.Time
+	bucket      StatsBucket
+	location    *time.Location
+}
+
+// NewTaskStatsQuery builds a stats query for the range, the last 30 days if from or to is nil.
+func NewTaskStatsQuery(userID, workspaceID string, from, to *time.Time,
+	bucket StatsBucket, location *time.Location) (*TaskStatsQuery, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	if bucket == "" {
+		bucket = StatsBucketDay
+	}
+
+	query := TaskStatsQuery{
+		userID:      userID,
+		workspaceID: workspaceID,
+		bucket:      bucket,
+		location:    location,
+	}
+
+	query.to = time.Now()
+	if to != nil {
+		query.to = *to
+	}
+
+	query.from = query.to.Add(-defaultStatsRange)
+	if from != nil {
+		query.from = *from
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
+
+func (q TaskStatsQuery) Validate() error {
+	if _, err := uuid.Parse(q.userID); err != nil {
+		return errors.ErrInvalidField
+	}
+
+	if _, err := uuid.Parse(q.workspaceID); err != nil {
+		return errors.ErrInvalidField
+	}
+
+	if q.from.After(q.to) || q.to.Sub(q.from) > maxStatsRange {
+		return errors.ErrInvalidField
+	}
+
+	switch q.bucket {
+	case StatsBucketDay, StatsBucketWeek:
+		return nil
+	default:
+		return errors.ErrInvalidField
+	}
+}
+
+func (q *TaskStatsQuery) UserID() string {
+	return q.userID
+}
+
+func (q *TaskStatsQuery) WorkspaceID() string {
+	return q.workspaceID
+}
+
+func (q *TaskStatsQuery) From() time.Time {
+	return q.from
+}
+
+func (q *TaskStatsQuery) To() time.Time {
+	return q.to
+}
+
+func (q *TaskStatsQuery) Bucket() StatsBucket {
+	return q.bucket
+}
+
+func (q *TaskStatsQuery) Location() *time.Location {
+	return q.location
+}
+
+// BucketStarts returns the start of every bucket in the range, in the query location.
+func (q *TaskStatsQuery) BucketStarts() []time.Time {
+	from := q.from.In(q.location)
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, q.location)
+
+	step := 1
+	if q.bucket == StatsBucketWeek {
+		// ISO weeks start on monday, like date_trunc('week')
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+		step = 7
+	}
+
+	var starts []time.Time
+	for ; !start.After(q.to); start = start.AddDate(0, 0, step) {
+		starts = append(starts, start)
+	}
+
+	return starts
+}
+
+type CompletedBucket struct {
+	Start int64
+	Count int64
+}
+
+type TaskStats struct {
+	OpenByStatus         map[TaskStatus]int64
+	OpenByPriority       map[TaskPriority]int64
+	OverdueCount         int64
+	Completed            []CompletedBucket // only buckets with completions
+	CompletedCount       int64
+	AvgCompletionSeconds int64 // lead time, created to done
+	AvgCycleTimeSeconds  int64 // started to done
+	// remaining effort of the open tasks, unestimated ones count as 0
+	OpenEstimateMinutes  int64
+	OpenStoryPoints      int64
+	CompletedStoryPoints int64
+}
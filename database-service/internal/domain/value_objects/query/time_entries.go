@@ -0,0 +1,103 @@
+package valueobjects
+
+import (
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const maxTimeEntries = 5000
+
+// TimeEntriesQuery selects the user's time entries on tasks of the workspace
+// overlapping the range, optionally only those of one task.
+type TimeEntriesQuery struct {
+	userID      string
+	workspaceID string
+	taskID      string
+	from        time.Time
+	to          time.Time
+	location    *time.Location
+}
+
+// NewTimeEntriesQuery builds a query for the range, the last 30 days if from or to is nil.
+func NewTimeEntriesQuery(userID, workspaceID, taskID string, from, to *time.Time, location *time.Location) (*TimeEntriesQuery, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	query := TimeEntriesQuery{
+		userID:      userID,
+		workspaceID: workspaceID,
+		taskID:      taskID,
+		location:    location,
+	}
+
+	query.to = time.Now()
+	if to != nil {
+		query.to = *to
+	}
+
+	query.from = query.to.Add(-defaultStatsRange)
+	if from != nil {
+		query.from = *from
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
+
+func (q TimeEntriesQuery) Validate() error {
+	if _, err := uuid.Parse(q.userID); err != nil {
+		return errors.ErrInvalidField
+	}
+
+	if _, err := uuid.Parse(q.workspaceID); err != nil {
+		return errors.ErrInvalidField
+	}
+
+	if q.taskID != "" {
+		if _, err := uuid.Parse(q.taskID); err != nil {
+			return errors.ErrInvalidField
+		}
+	}
+
+	if q.from.After(q.to) || q.to.Sub(q.from) > maxStatsRange {
+		return errors.ErrInvalidField
+	}
+
+	return nil
+}
+
+func (q *TimeEntriesQuery) UserID() string {
+	return q.userID
+}
+
+func (q *TimeEntriesQuery) WorkspaceID() string {
+	return q.workspaceID
+}
+
+// TaskID returns the task to select entries of, "" for all tasks.
+func (q *TimeEntriesQuery) TaskID() string {
+	return q.taskID
+}
+
+func (q *TimeEntriesQuery) From() time.Time {
+	return q.from
+}
+
+func (q *TimeEntriesQuery) To() time.Time {
+	return q.to
+}
+
+func (q *TimeEntriesQuery) Location() *time.Location {
+	return q.location
+}
+
+// Limit returns the most entries a range may have, reports of more would be incomplete.
+func (q *TimeEntriesQuery) Limit() int {
+	return maxTimeEntries
+}
@@ -0,0 +1,41 @@
+package valueobjects
+
+import (
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// StatusCategory is what a workflow status means for the task:
+// not started, being worked on or finished.
+type StatusCategory uint8
+
+const (
+	StatusCategoryTodo StatusCategory = iota
+	StatusCategoryActive
+	StatusCategoryDone
+)
+
+func NewStatusCategory(category uint8) (*StatusCategory, error) {
+	c := StatusCategory(category)
+	if ok := c.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &c, nil
+}
+
+func (c StatusCategory) String() string {
+	switch c {
+	case StatusCategoryTodo:
+		return "todo"
+	case StatusCategoryActive:
+		return "active"
+	case StatusCategoryDone:
+		return "done"
+	default:
+		return "unknown"
+	}
+}
+
+func (c StatusCategory) IsValid() bool {
+	return c <= StatusCategoryDone
+}
@@ -1,13 +1,18 @@
 package valueobjects
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/braunkc/todo-app/database-service/pkg/errors"
 )
 
+// TaskDueDate is a unix time; 0 means the task has no due date.
 type TaskDueDate int64
 
+const maxDueDateAhead = int64(time.Hour * 24 * 30 * 12 * 100 / time.Second)
+
 func NewDueDate(dueDate int64) (*TaskDueDate, error) {
 	dd := TaskDueDate(dueDate)
 	if err := dd.Validate(); err != nil {
@@ -17,13 +22,112 @@ func NewDueDate(dueDate int64) (*TaskDueDate, error) {
 	return &dd, nil
 }
 
+// ParseDueDate accepts an RFC 3339 timestamp, a YYYY-MM-DD date (UTC midnight)
+// or unix seconds.
+func ParseDueDate(dueDate string) (*TaskDueDate, error) {
+	dueDate = strings.TrimSpace(dueDate)
+	if dueDate == "" {
+		return NewDueDate(0)
+	}
+
+	if t, err := time.Parse(time.RFC3339, dueDate); err == nil {
+		return NewDueDate(t.Unix())
+	}
+
+	if t, err := time.Parse(time.DateOnly, dueDate); err == nil {
+		return NewDueDate(t.Unix())
+	}
+
+	unix, err := strconv.ParseInt(dueDate, 10, 64)
+	if err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	return NewDueDate(unix)
+}
+
+// Validate checks a due date given when a task is created:
+// it must be in the future unless the task has no due date.
 func (dd TaskDueDate) Validate() error {
+	if dd == 0 {
+		return nil
+	}
+
 	now := time.Now().UTC().Unix()
 	due := int64(dd)
 	if now >= due ||
-		now+int64(time.Hour*24*30*12*100) <= due {
+		now+maxDueDateAhead <= due {
 		return errors.ErrInvalidField
 	}
 
 	return nil
 }
+
+// NewChangedDueDate checks a due date set on an existing task.
+// Past dates are allowed so overdue tasks stay editable, and 0 clears the due date.
+func NewChangedDueDate(dueDate int64, allDay bool) (*TaskDueDate, error) {
+	if dueDate != 0 && allDay {
+		dueDate = DateOf(time.Unix(dueDate, 0).UTC())
+	}
+
+	if dueDate < 0 ||
+		time.Now().UTC().Unix()+maxDueDateAhead <= dueDate {
+		return nil, errors.ErrInvalidField
+	}
+
+	dd := TaskDueDate(dueDate)
+	return &dd, nil
+}
+
+// ParseImportedDueDate parses a due date like ParseDueDate, but checks it by the rules
+// of changed due dates: imported tasks keep their history, so past dates are allowed.
+// Dates without a time (YYYY-MM-DD) are all-day.
+func ParseImportedDueDate(dueDate string) (*TaskDueDate, bool, error) {
+	dueDate = strings.TrimSpace(dueDate)
+	if dueDate == "" {
+		dd, err := NewChangedDueDate(0, false)
+		return dd, false, err
+	}
+
+	if t, err := time.Parse(time.RFC3339, dueDate); err == nil {
+		dd, err := NewChangedDueDate(t.Unix(), false)
+		return dd, false, err
+	}
+
+	if t, err := time.Parse(time.DateOnly, dueDate); err == nil {
+		dd, err := NewChangedDueDate(t.Unix(), true)
+		return dd, true, err
+	}
+
+	unix, err := strconv.ParseInt(dueDate, 10, 64)
+	if err != nil {
+		return nil, false, errors.ErrInvalidField
+	}
+
+	dd, err := NewChangedDueDate(unix, false)
+	return dd, false, err
+}
+
+// NewAllDayDueDate keeps only the date of dueDate, stored as its midnight UTC.
+// All-day tasks are due by the end of that date in the user's timezone,
+// so a date is valid until the day is over in loc.
+func NewAllDayDueDate(dueDate int64, loc *time.Location) (*TaskDueDate, error) {
+	if dueDate == 0 {
+		return NewDueDate(0)
+	}
+
+	dd := TaskDueDate(DateOf(time.Unix(dueDate, 0).UTC()))
+	today := DateOf(time.Now().In(loc))
+	if int64(dd) < today ||
+		today+maxDueDateAhead <= int64(dd) {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &dd, nil
+}
+
+// DateOf returns the calendar date of t as the unix time of its midnight UTC,
+// the way all-day due dates are stored.
+func DateOf(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
+}
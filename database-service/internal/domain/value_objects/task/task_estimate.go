@@ -0,0 +1,22 @@
+package valueobjects
+
+import "github.com/braunkc/todo-app/database-service/pkg/errors"
+
+// TaskEstimate is the expected effort in minutes, 0 means the task isn't estimated.
+type TaskEstimate uint32
+
+// MaxTaskEstimate is a year of full-time work, larger tasks should be split.
+const MaxTaskEstimate TaskEstimate = 2000 * 60
+
+func NewTaskEstimate(minutes uint32) (*TaskEstimate, error) {
+	e := TaskEstimate(minutes)
+	if ok := e.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &e, nil
+}
+
+func (e TaskEstimate) IsValid() bool {
+	return e <= MaxTaskEstimate
+}
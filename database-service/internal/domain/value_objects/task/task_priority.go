@@ -1,6 +1,11 @@
 package valueobjects
 
-import "github.com/braunkc/todo-app/database-service/pkg/errors"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
 
 type TaskPriority uint8
 
@@ -19,6 +24,39 @@ func NewTaskPriority(priority uint8) (*TaskPriority, error) {
 	return &p, nil
 }
 
+// ParseTaskPriority accepts a priority name (low, medium, high) or its number.
+// An empty string is parsed as low.
+func ParseTaskPriority(priority string) (*TaskPriority, error) {
+	switch strings.ToLower(strings.TrimSpace(priority)) {
+	case "", "low":
+		return NewTaskPriority(uint8(TaskPriorityLow))
+	case "medium":
+		return NewTaskPriority(uint8(TaskPriorityMedium))
+	case "high":
+		return NewTaskPriority(uint8(TaskPriorityHigh))
+	}
+
+	n, err := strconv.ParseUint(strings.TrimSpace(priority), 10, 8)
+	if err != nil {
+		return nil, errors.ErrInvalidField
+	}
+
+	return NewTaskPriority(uint8(n))
+}
+
+func (p TaskPriority) String() string {
+	switch p {
+	case TaskPriorityLow:
+		return "low"
+	case TaskPriorityMedium:
+		return "medium"
+	case TaskPriorityHigh:
+		return "high"
+	default:
+		return strconv.Itoa(int(p))
+	}
+}
+
 func (p TaskPriority) IsValid() bool {
 	return p <= TaskPriorityHigh
 }
@@ -0,0 +1,27 @@
+package valueobjects
+
+import (
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TaskProject is a free-form label of what the task is part of, empty for none.
+type TaskProject string
+
+func NewTaskProject(project string) (*TaskProject, error) {
+	p := TaskProject(strings.TrimSpace(project))
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (p TaskProject) Validate() error {
+	if len(p) > 64 {
+		return errors.ErrTooLongField
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package valueobjects
+
+import (
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TaskRank places a task in its owner's manual order, tasks sort by it byte-wise.
+// It's a base 36 fraction without the leading "0.", so there is always a rank
+// between two others and moving a task never renumbers its neighbours.
+type TaskRank string
+
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// MaxTaskRankLength is how long ranks may get by moving tasks into the same gap
+// before the ranks of the whole list are spread out again.
+const MaxTaskRankLength = 24
+
+func NewTaskRank(rank string) (*TaskRank, error) {
+	r := TaskRank(rank)
+	if ok := r.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &r, nil
+}
+
+// NewTaskRankBetween returns a rank between before and after, "" stands for the start
+// or the end of the list.
+func NewTaskRankBetween(before, after TaskRank) (*TaskRank, error) {
+	if (before != "" && !before.IsValid()) || (after != "" && !after.IsValid()) {
+		return nil, errors.ErrInvalidField
+	}
+
+	if before != "" && after != "" && before >= after {
+		return nil, errors.ErrInvalidField
+	}
+
+	r := TaskRank(midpoint(string(before), string(after)))
+	return &r, nil
+}
+
+// NewTaskRanksAfter returns n increasing ranks after last, "" for an empty list.
+// They share the prefix of the next rank after last, so a batch makes ranks
+// only a few digits longer instead of one digit for every few tasks.
+func NewTaskRanksAfter(last TaskRank, n int) ([]TaskRank, error) {
+	if last != "" && !last.IsValid() {
+		return nil, errors.ErrInvalidField
+	}
+
+	next := midpoint(string(last), "")
+	if n == 1 {
+		return []TaskRank{TaskRank(next)}, nil
+	}
+
+	ranks := SpreadTaskRanks(n)
+	for i := range ranks {
+		ranks[i] = TaskRank(next) + ranks[i]
+	}
+
+	return ranks, nil
+}
+
+// SpreadTaskRanks returns n increasing ranks evenly spaced with room for
+// many moves between any two of them.
+func SpreadTaskRanks(n int) []TaskRank {
+	base := uint64(len(rankDigits))
+	width, space := 2, base*base
+	for space/uint64(n+1) < base*base {
+		width++
+		space *= base
+	}
+
+	step := space / uint64(n+1)
+	ranks := make([]TaskRank, 0, n)
+	for i := 1; i <= n; i++ {
+		ranks = append(ranks, formatRank(uint64(i)*step, width))
+	}
+
+	return ranks
+}
+
+func (r TaskRank) IsValid() bool {
+	if r == "" || strings.HasSuffix(string(r), "0") {
+		return false
+	}
+
+	for i := 0; i < len(r); i++ {
+		if strings.IndexByte(rankDigits, r[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NeedsRebalance reports whether the rank got too long and the list should be spread out.
+func (r TaskRank) NeedsRebalance() bool {
+	return len(r) > MaxTaskRankLength
+}
+
+// midpoint returns the shortest rank between a and b, b is "" for no upper bound.
+// Missing digits of a count as zeros, which is why ranks never end with one.
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	lo := strings.IndexByte(rankDigits, digitAt(a, 0))
+	hi := len(rankDigits)
+	if b != "" {
+		hi = strings.IndexByte(rankDigits, b[0])
+	}
+
+	if hi-lo > 1 {
+		return string(rankDigits[(lo+hi+1)/2])
+	}
+
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	return string(rankDigits[lo]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+
+	return rankDigits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+
+	return ""
+}
+
+func formatRank(v uint64, width int) TaskRank {
+	base := uint64(len(rankDigits))
+	digits := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		digits[i] = rankDigits[v%base]
+		v /= base
+	}
+
+	return TaskRank(strings.TrimRight(string(digits), "0"))
+}
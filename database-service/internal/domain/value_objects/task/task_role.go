@@ -0,0 +1,67 @@
+package valueobjects
+
+import (
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TaskRole is what a user may do with a task. The task's user is always its owner,
+// other users get a role when the task is shared with them.
+type TaskRole uint8
+
+const (
+	TaskRoleViewer TaskRole = iota
+	TaskRoleEditor
+	TaskRoleOwner
+)
+
+func NewTaskRole(role uint8) (*TaskRole, error) {
+	r := TaskRole(role)
+	if ok := r.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &r, nil
+}
+
+// ParseTaskRole accepts a role name (viewer, editor, owner).
+func ParseTaskRole(role string) (*TaskRole, error) {
+	switch strings.ToLower(strings.TrimSpace(role)) {
+	case "viewer":
+		return NewTaskRole(uint8(TaskRoleViewer))
+	case "editor":
+		return NewTaskRole(uint8(TaskRoleEditor))
+	case "owner":
+		return NewTaskRole(uint8(TaskRoleOwner))
+	default:
+		return nil, errors.ErrInvalidField
+	}
+}
+
+func (r TaskRole) String() string {
+	switch r {
+	case TaskRoleViewer:
+		return "viewer"
+	case TaskRoleEditor:
+		return "editor"
+	case TaskRoleOwner:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
+func (r TaskRole) IsValid() bool {
+	return r <= TaskRoleOwner
+}
+
+// CanEdit reports whether the role may change the task and track time on it.
+func (r TaskRole) CanEdit() bool {
+	return r >= TaskRoleEditor
+}
+
+// CanManage reports whether the role may delete the task and share it.
+func (r TaskRole) CanManage() bool {
+	return r == TaskRoleOwner
+}
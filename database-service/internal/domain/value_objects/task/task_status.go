@@ -1,7 +1,14 @@
 package valueobjects
 
-import "github.com/braunkc/todo-app/database-service/pkg/errors"
+import (
+	"strconv"
+	"strings"
 
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TaskStatus is the ID of a status in the user's workflow.
+// The first three are the statuses of the default workflow.
 type TaskStatus uint8
 
 const (
@@ -10,15 +17,41 @@ const (
 	TaskStatusDone
 )
 
+// NewTaskStatus accepts any status ID, the workflow decides which ones exist.
 func NewTaskStatus(status uint8) (*TaskStatus, error) {
 	s := TaskStatus(status)
-	if ok := s.IsValid(); !ok {
+	return &s, nil
+}
+
+// ParseTaskStatus accepts a status name (todo, in_progress, done) or its number.
+// An empty string is parsed as todo.
+func ParseTaskStatus(status string) (*TaskStatus, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(status)), " ", "_") {
+	case "", "todo":
+		return NewTaskStatus(uint8(TaskStatusTodo))
+	case "in_progress":
+		return NewTaskStatus(uint8(TaskStatusInProgress))
+	case "done":
+		return NewTaskStatus(uint8(TaskStatusDone))
+	}
+
+	n, err := strconv.ParseUint(strings.TrimSpace(status), 10, 8)
+	if err != nil {
 		return nil, errors.ErrInvalidField
 	}
 
-	return &s, nil
+	return NewTaskStatus(uint8(n))
 }
 
-func (s TaskStatus) IsValid() bool {
-	return s <= TaskStatusDone
+func (s TaskStatus) String() string {
+	switch s {
+	case TaskStatusTodo:
+		return "todo"
+	case TaskStatusInProgress:
+		return "in_progress"
+	case TaskStatusDone:
+		return "done"
+	default:
+		return strconv.Itoa(int(s))
+	}
 }
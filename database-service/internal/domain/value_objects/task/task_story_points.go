@@ -0,0 +1,21 @@
+package valueobjects
+
+import "github.com/braunkc/todo-app/database-service/pkg/errors"
+
+// TaskStoryPoints is the relative size of a task, 0 means it isn't sized.
+type TaskStoryPoints uint32
+
+const MaxTaskStoryPoints TaskStoryPoints = 100
+
+func NewTaskStoryPoints(points uint32) (*TaskStoryPoints, error) {
+	p := TaskStoryPoints(points)
+	if ok := p.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &p, nil
+}
+
+func (p TaskStoryPoints) IsValid() bool {
+	return p <= MaxTaskStoryPoints
+}
@@ -0,0 +1,56 @@
+package valueobjects
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TaskTags are the labels of a task, kept as a sorted comma separated list
+// so tasks stay comparable. Tags are lower case without commas or spaces.
+type TaskTags string
+
+const (
+	MaxTaskTags      = 10
+	maxTaskTagLength = 32
+)
+
+func NewTaskTags(tags []string) (*TaskTags, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		if len(tag) > maxTaskTagLength {
+			return nil, errors.ErrTooLongField
+		}
+
+		if strings.ContainsFunc(tag, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+			return nil, errors.ErrInvalidField
+		}
+
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	if len(normalized) > MaxTaskTags {
+		return nil, errors.ErrInvalidField
+	}
+
+	t := TaskTags(strings.Join(normalized, ","))
+	return &t, nil
+}
+
+// List returns the tags in order, nil if there are none.
+func (t TaskTags) List() []string {
+	if t == "" {
+		return nil
+	}
+
+	return strings.Split(string(t), ",")
+}
@@ -0,0 +1,46 @@
+package valueobjects
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// DueOffset is when a task made from a template is due, relative to when it's made:
+// "+4h" is due in four hours, "+3d" and "+2w" on the date three days or two weeks later.
+// An empty offset makes tasks without a due date.
+type DueOffset string
+
+var dueOffsetPattern = regexp.MustCompile(`^\+?(\d{1,4})([hdw])$`)
+
+func NewDueOffset(offset string) (*DueOffset, error) {
+	o := DueOffset(strings.TrimSpace(offset))
+	if o != "" && !dueOffsetPattern.MatchString(string(o)) {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &o, nil
+}
+
+// DueDate returns the due date the offset gives a task made at now, whether it's all-day
+// and 0 for no due date. Offsets in days or weeks count calendar days in loc.
+func (o DueOffset) DueDate(now time.Time, loc *time.Location) (int64, bool) {
+	match := dueOffsetPattern.FindStringSubmatch(string(o))
+	if match == nil {
+		return 0, false
+	}
+
+	n, _ := strconv.Atoi(match[1])
+	switch match[2] {
+	case "h":
+		return now.Add(time.Duration(n) * time.Hour).Unix(), false
+	case "w":
+		n *= 7
+	}
+
+	return taskvo.DateOf(now.In(loc).AddDate(0, 0, n)), true
+}
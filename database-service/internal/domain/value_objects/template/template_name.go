@@ -0,0 +1,30 @@
+package valueobjects
+
+import (
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+type TemplateName string
+
+func NewTemplateName(name string) (*TemplateName, error) {
+	n := TemplateName(strings.TrimSpace(name))
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+func (n TemplateName) Validate() error {
+	if n == "" {
+		return errors.ErrEmptyField
+	}
+
+	if len(n) > 64 {
+		return errors.ErrTooLongField
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// TemplateText is a title or description with {{name}} placeholders,
+// filled in when a task is made from the template.
+type TemplateText string
+
+// DateVariable is always set to the date a template is instantiated on.
+const DateVariable = "date"
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+func NewTemplateText(text string) (*TemplateText, error) {
+	t := TemplateText(text)
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Validate checks every {{ starts a well-formed placeholder.
+func (t TemplateText) Validate() error {
+	rest := placeholderPattern.ReplaceAllString(string(t), "")
+	if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return errors.ErrInvalidField
+	}
+
+	return nil
+}
+
+// Placeholders returns the names of the variables the text uses.
+func (t TemplateText) Placeholders() []string {
+	var names []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(string(t), -1) {
+		names = append(names, match[1])
+	}
+
+	return names
+}
+
+// Render fills in the placeholders from vars, every variable used must be set.
+func (t TemplateText) Render(vars map[string]string) (string, error) {
+	for _, name := range t.Placeholders() {
+		if _, ok := vars[name]; !ok {
+			return "", fmt.Errorf("%w %q", errors.ErrMissingTemplateVariable, name)
+		}
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(string(t), func(placeholder string) string {
+		return vars[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	}), nil
+}
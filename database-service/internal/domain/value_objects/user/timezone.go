@@ -0,0 +1,41 @@
+package valueobjects
+
+import (
+	"time"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// Timezone is the IANA name of the user's timezone, empty until the user sets one.
+type Timezone string
+
+func NewTimezone(timezone string) (*Timezone, error) {
+	tz := Timezone(timezone)
+	if err := tz.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &tz, nil
+}
+
+func (tz Timezone) Validate() error {
+	if len(tz) > 64 {
+		return errors.ErrTooLongField
+	}
+
+	if _, err := time.LoadLocation(string(tz)); err != nil {
+		return errors.ErrInvalidField
+	}
+
+	return nil
+}
+
+// Location returns the timezone's location, UTC if it's not set.
+func (tz Timezone) Location() *time.Location {
+	loc, err := time.LoadLocation(string(tz))
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
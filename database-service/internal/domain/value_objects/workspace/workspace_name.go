@@ -0,0 +1,31 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+type WorkspaceName string
+
+func NewWorkspaceName(name string) (*WorkspaceName, error) {
+	n := WorkspaceName(strings.TrimSpace(name))
+	if err := n.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate workspace name: %w", err)
+	}
+
+	return &n, nil
+}
+
+func (n WorkspaceName) Validate() error {
+	if n == "" {
+		return errors.ErrEmptyField
+	}
+
+	if len(n) > 64 {
+		return errors.ErrTooLongField
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package valueobjects
+
+import (
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+)
+
+// WorkspaceRole is what a member may do in a workspace.
+// The user who creates a workspace is its owner.
+type WorkspaceRole uint8
+
+const (
+	WorkspaceRoleMember WorkspaceRole = iota
+	WorkspaceRoleAdmin
+	WorkspaceRoleOwner
+)
+
+func NewWorkspaceRole(role uint8) (*WorkspaceRole, error) {
+	r := WorkspaceRole(role)
+	if ok := r.IsValid(); !ok {
+		return nil, errors.ErrInvalidField
+	}
+
+	return &r, nil
+}
+
+func (r WorkspaceRole) String() string {
+	switch r {
+	case WorkspaceRoleMember:
+		return "member"
+	case WorkspaceRoleAdmin:
+		return "admin"
+	case WorkspaceRoleOwner:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
+func (r WorkspaceRole) IsValid() bool {
+	return r <= WorkspaceRoleOwner
+}
+
+// CanInvite reports whether the role may invite users to the workspace.
+func (r WorkspaceRole) CanInvite() bool {
+	return r >= WorkspaceRoleAdmin
+}
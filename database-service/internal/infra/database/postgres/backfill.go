@@ -0,0 +1,95 @@
+package database
+
+import (
+	"time"
+
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+	workspacevo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/workspace"
+	"github.com/braunkc/todo-app/database-service/internal/infra/database/postgres/models"
+	"gorm.io/gorm"
+)
+
+// backfillTaskTimestamps fills the status timestamps of tasks created before they were tracked.
+// The real transition times are unknown, so the last update is the best guess.
+// It only touches rows with missing values, so running it on every start is a no-op once done.
+func backfillTaskTimestamps(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		tasks := func() *gorm.DB {
+			return tx.Model(&models.Task{})
+		}
+
+		if err := tasks().Where("updated_at = 0").
+			UpdateColumn("updated_at", gorm.Expr("created_at")).Error; err != nil {
+			return err
+		}
+
+		if err := tasks().Where("status_category = ? AND completed_at = 0", valueobjects.StatusCategoryDone).
+			UpdateColumn("completed_at", gorm.Expr("GREATEST(updated_at, created_at)")).Error; err != nil {
+			return err
+		}
+
+		if err := tasks().Where("status_category = ? AND started_at = 0", valueobjects.StatusCategoryActive).
+			UpdateColumn("started_at", gorm.Expr("GREATEST(updated_at, created_at)")).Error; err != nil {
+			return err
+		}
+
+		// done tasks without a known start count as started when they were done
+		return tasks().Where("status_category = ? AND started_at = 0", valueobjects.StatusCategoryDone).
+			UpdateColumn("started_at", gorm.Expr("completed_at")).Error
+	})
+}
+
+// backfillStatusCategories sets the category of tasks created before workflows.
+// Their users are on the default workflow, where the in progress and done statuses
+// have the same numbers as their categories.
+func backfillStatusCategories(db *gorm.DB) error {
+	return db.Model(&models.Task{}).
+		Where("status IN ? AND status_category = 0", []valueobjects.TaskStatus{valueobjects.TaskStatusInProgress, valueobjects.TaskStatusDone}).
+		Where("user_id NOT IN (?)", db.Model(&models.WorkflowStatus{}).Select("user_id")).
+		UpdateColumn("status_category", gorm.Expr("status")).Error
+}
+
+// backfillTaskRanks ranks the tasks created before manual ordering after the ranked tasks
+// of their list, in the order they were created. It's a no-op once done.
+func backfillTaskRanks(db *gorm.DB) error {
+	var lists []struct {
+		UserID      string
+		WorkspaceID string
+	}
+	if err := db.Model(&models.Task{}).Distinct("user_id", "workspace_id").
+		Where("rank = ''").Scan(&lists).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, list := range lists {
+			if err := rebalanceTaskRanks(tx, list.UserID, list.WorkspaceID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// backfillWorkspaces gives users created before workspaces their personal workspace
+// and moves their tasks into it. Like the other backfills it's a no-op once done.
+func backfillWorkspaces(db *gorm.DB) error {
+	now := time.Now().Unix()
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO workspaces (id, name, created_at)
+			SELECT id, username, ? FROM users WHERE id NOT IN (SELECT id FROM workspaces)`, now).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`INSERT INTO memberships (workspace_id, user_id, role, created_at)
+			SELECT id, id, ?, ? FROM users
+			WHERE NOT EXISTS (SELECT 1 FROM memberships m WHERE m.workspace_id = users.id AND m.user_id = users.id)`,
+			workspacevo.WorkspaceRoleOwner, now).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Task{}).Where("workspace_id IS NULL").
+			UpdateColumn("workspace_id", gorm.Expr("user_id")).Error
+	})
+}
@@ -3,14 +3,19 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/braunkc/todo-app/database-service/config"
 	"github.com/braunkc/todo-app/database-service/internal/application/repository"
 	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
 	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/query"
+	taskvo "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
 	"github.com/braunkc/todo-app/database-service/internal/infra/database/postgres/models"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/google/uuid"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type databaseRepository struct {
@@ -33,6 +38,60 @@ func NewDatabaseService(cfg *config.Config, mapper Mapper) (repository.Repositor
 	if err := db.AutoMigrate(&models.Task{}); err != nil {
 		return nil, fmt.Errorf("failed to migrate task: %w", err)
 	}
+	if err := db.AutoMigrate(&models.WorkflowStatus{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate workflow status: %w", err)
+	}
+	if err := db.AutoMigrate(&models.WorkflowTransition{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate workflow transition: %w", err)
+	}
+	if err := backfillStatusCategories(db); err != nil {
+		return nil, fmt.Errorf("failed to backfill status categories: %w", err)
+	}
+	if err := backfillTaskTimestamps(db); err != nil {
+		return nil, fmt.Errorf("failed to backfill task timestamps: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TaskImport{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate task import: %w", err)
+	}
+	if err := db.AutoMigrate(&models.CalendarFeed{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate calendar feed: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TimeEntry{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate time entry: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TaskCollaborator{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate task collaborator: %w", err)
+	}
+	if err := db.AutoMigrate(&models.Workspace{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate workspace: %w", err)
+	}
+	if err := db.AutoMigrate(&models.Membership{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate membership: %w", err)
+	}
+	if err := db.AutoMigrate(&models.WorkspaceInvitation{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate workspace invitation: %w", err)
+	}
+	if err := backfillWorkspaces(db); err != nil {
+		return nil, fmt.Errorf("failed to backfill workspaces: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TaskAssignment{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate task assignment: %w", err)
+	}
+	if err := backfillTaskRanks(db); err != nil {
+		return nil, fmt.Errorf("failed to backfill task ranks: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TaskCommand{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate task command: %w", err)
+	}
+	if err := db.AutoMigrate(&models.TaskTemplate{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate task template: %w", err)
+	}
+	if err := db.AutoMigrate(&models.Session{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate session: %w", err)
+	}
+	if err := db.AutoMigrate(&models.RefreshToken{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate refresh token: %w", err)
+	}
 
 	return &databaseRepository{
 		db:     db,
@@ -40,13 +99,21 @@ func NewDatabaseService(cfg *config.Config, mapper Mapper) (repository.Repositor
 	}, nil
 }
 
-func (r *databaseRepository) CreateUser(ctx context.Context, user *entities.User) (*entities.User, error) {
+// CreateUser creates the user together with their personal workspace.
+func (r *databaseRepository) CreateUser(ctx context.Context, user *entities.User,
+	workspace *entities.Workspace, owner *entities.Membership) (*entities.User, error) {
 	u, err := r.mapper.UserToModel(user)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := r.db.WithContext(ctx).Create(u).Error; err != nil {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(u).Error; err != nil {
+			return err
+		}
+
+		return r.createWorkspace(tx, workspace, owner)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -62,6 +129,24 @@ func (r *databaseRepository) GetUserByUsername(ctx context.Context, username str
 	return r.mapper.UserToDomain(&user), nil
 }
 
+func (r *databaseRepository) GetUserByID(ctx context.Context, ID string) (*entities.User, error) {
+	var user models.User
+	if err := r.db.WithContext(ctx).First(&user, "id = ?", ID).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.UserToDomain(&user), nil
+}
+
+func (r *databaseRepository) UpdateUser(ctx context.Context, user *entities.User) error {
+	u, err := r.mapper.UserToModel(user)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(u).Error
+}
+
 func (r *databaseRepository) DeleteUserByID(ctx context.Context, ID string) error {
 	return r.db.WithContext(ctx).Where("id = ?", ID).Delete(&models.User{}).Error
 }
@@ -89,15 +174,21 @@ func (r *databaseRepository) GetTask(ctx context.Context, ID string) (*entities.
 }
 
 func (r *databaseRepository) GetTasks(ctx context.Context, query *valueobjects.GetTasksQuery) ([]*entities.Task, int64, int64, error) {
-	q := r.db.Model(&models.Task{}).Where("user_id = ?", query.UserID())
-
-	if len(query.Filters().Statuses) > 0 {
-		q = q.Where("status IN ?", query.Filters().Statuses)
-	}
-
-	if len(query.Filters().Priorities) > 0 {
-		q = q.Where("priority IN ?", query.Filters().Priorities)
+	q := r.db.Model(&models.Task{})
+	filters := query.Filters()
+	// tasks shared with the user are listed whatever workspace they're in
+	shared := r.db.Model(&models.TaskCollaborator{}).Select("task_id").Where("user_id = ?", query.UserID())
+	switch {
+	case filters.SharedWithMe && filters.AssignedToMe:
+		q = q.Where("(id IN (?) OR (assignee_id = ? AND workspace_id = ?))", shared, query.UserID(), query.WorkspaceID())
+	case filters.SharedWithMe:
+		q = q.Where("id IN (?)", shared)
+	case filters.AssignedToMe:
+		q = q.Where("assignee_id = ? AND workspace_id = ?", query.UserID(), query.WorkspaceID())
+	default:
+		q = q.Where("user_id = ? AND workspace_id = ?", query.UserID(), query.WorkspaceID())
 	}
+	q = applyFilters(q, query.Filters())
 
 	if query.Title() != "" {
 		// ILIKE for postgres
@@ -114,24 +205,11 @@ func (r *databaseRepository) GetTasks(ctx context.Context, query *valueobjects.G
 		return []*entities.Task{}, 0, 0, nil
 	}
 
-	var orderField string
-	switch query.OrderBy().Field {
-	case valueobjects.SortByPriority:
-		orderField = "priority"
-	case valueobjects.SortByDueDate:
-		orderField = "due_date"
-	case valueobjects.SortByCreatedAt:
-		orderField = "created_at"
-	default:
-		orderField = "priority"
-	}
-
-	dir := "ASC"
-	if query.OrderBy().Direction == valueobjects.SortDesc {
-		dir = "DESC"
+	for _, key := range query.OrderBy() {
+		q = q.Order(fmt.Sprintf("%s %s NULLS %s", sortColumns[key.Field], key.Direction, key.Nulls))
 	}
-
-	q = q.Order(orderField + " " + dir)
+	// tie-breaker so rows with equal keys keep the same order between pages
+	q = q.Order("id")
 
 	offset := (query.PageNumber() - 1) * query.PageSize()
 	q = q.Limit(int(query.PageSize())).Offset(int(offset))
@@ -164,6 +242,1112 @@ func (r *databaseRepository) UpdateTask(ctx context.Context, task *entities.Task
 	return r.mapper.TaskToDomain(t), nil
 }
 
+// AssignTask saves the task's new assignee together with the record of the reassignment.
+func (r *databaseRepository) AssignTask(ctx context.Context, task *entities.Task, assignment *entities.TaskAssignment) error {
+	t, err := r.mapper.TaskToModel(task)
+	if err != nil {
+		return err
+	}
+
+	a, err := r.mapper.TaskAssignmentToModel(assignment)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(t).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(a).Error
+	})
+}
+
+// CreateTasks creates all the tasks or none, parents before their subtasks.
+func (r *databaseRepository) CreateTasks(ctx context.Context, tasks []*entities.Task) error {
+	t := make([]*models.Task, 0, len(tasks))
+	for _, task := range tasks {
+		m, err := r.mapper.TaskToModel(task)
+		if err != nil {
+			return err
+		}
+		t = append(t, m)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range t {
+			if err := tx.Create(m).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetLastTaskRank returns the rank of the last task in the user's manual order of the workspace,
+// "" if there are no tasks.
+func (r *databaseRepository) GetLastTaskRank(ctx context.Context, userID, workspaceID string) (string, error) {
+	var ranks []string
+	if err := r.db.WithContext(ctx).Model(&models.Task{}).
+		Where("user_id = ? AND workspace_id = ?", userID, workspaceID).
+		Order(sortColumns[valueobjects.SortByManual]+" DESC").Limit(1).
+		Pluck("rank", &ranks).Error; err != nil {
+		return "", err
+	}
+
+	if len(ranks) == 0 {
+		return "", nil
+	}
+
+	return ranks[0], nil
+}
+
+func (r *databaseRepository) RebalanceTaskRanks(ctx context.Context, userID, workspaceID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return rebalanceTaskRanks(tx, userID, workspaceID)
+	})
+}
+
+// rebalanceTaskRanks spreads the ranks of the user's tasks in the workspace evenly, keeping their order.
+// Tasks without a rank go last in the order they were created.
+func rebalanceTaskRanks(tx *gorm.DB, userID, workspaceID string) error {
+	var IDs []uuid.UUID
+	if err := tx.Model(&models.Task{}).
+		Where("user_id = ? AND workspace_id = ?", userID, workspaceID).
+		Order("rank = ''").Order(sortColumns[valueobjects.SortByManual]).Order("created_at").Order("id").
+		Pluck("id", &IDs).Error; err != nil {
+		return err
+	}
+
+	for i, rank := range taskvo.SpreadTaskRanks(len(IDs)) {
+		if err := tx.Model(&models.Task{}).Where("id = ?", IDs[i]).
+			UpdateColumn("rank", string(rank)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *databaseRepository) DeleteTasks(ctx context.Context, IDs []string) error {
 	return r.db.WithContext(ctx).Where("id IN ?", IDs).Delete(&models.Task{}).Error
 }
+
+func (r *databaseRepository) GetUserTasksByIDs(ctx context.Context, userID, workspaceID string, IDs []string) ([]*entities.Task, error) {
+	var t []models.Task
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND workspace_id = ? AND id IN ?", userID, workspaceID, IDs).
+		Find(&t).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*entities.Task, 0, len(t))
+	for _, task := range t {
+		tasks = append(tasks, r.mapper.TaskToDomain(&task))
+	}
+
+	return tasks, nil
+}
+
+func (r *databaseRepository) GetUserTasksByFilters(ctx context.Context, userID, workspaceID string,
+	filters valueobjects.TaskFilters, limit int) ([]*entities.Task, error) {
+	q := applyFilters(r.db.Model(&models.Task{}).Where("user_id = ? AND workspace_id = ?", userID, workspaceID), filters)
+
+	var t []models.Task
+	if err := q.WithContext(ctx).Order("created_at, id").Limit(limit).Find(&t).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*entities.Task, 0, len(t))
+	for _, task := range t {
+		tasks = append(tasks, r.mapper.TaskToDomain(&task))
+	}
+
+	return tasks, nil
+}
+
+func (r *databaseRepository) UpdateTasks(ctx context.Context, tasks []*entities.Task) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, task := range tasks {
+			t, err := r.mapper.TaskToModel(task)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Save(t).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// allDayDeadline is when an all-day task is due: the end of its date in the timezone
+// of its owner's settings, UTC if they didn't set one.
+const allDayDeadline = `EXTRACT(EPOCH FROM (to_timestamp(tasks.due_date) AT TIME ZONE 'UTC' + interval '1 day') AT TIME ZONE
+	COALESCE((SELECT NULLIF(users.timezone, '') FROM users WHERE users.id = tasks.user_id), 'UTC'))`
+
+// RaisePriorities raises open tasks below priority to it once their deadline is before dueBefore,
+// all-day tasks being due by the end of their date in their owner's timezone.
+// It returns the users whose tasks changed.
+func (r *databaseRepository) RaisePriorities(ctx context.Context, dueBefore int64, priority valueobjects.TaskPriority) ([]string, error) {
+	var tasks []models.Task
+	if err := r.db.WithContext(ctx).Model(&tasks).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "user_id"}}}).
+		Where("due_date > 0 AND status_category <> ? AND priority < ?", valueobjects.StatusCategoryDone, priority).
+		Where("CASE WHEN all_day THEN "+allDayDeadline+" ELSE due_date END < ?", dueBefore).
+		Updates(map[string]any{
+			"priority":   priority,
+			"updated_at": time.Now().Unix(),
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(tasks))
+	userIDs := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		userID := t.UserID.String()
+		if !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs, nil
+}
+
+// GetImportedTaskIDs returns the IDs of tasks already created from the given row hashes, keyed by hash.
+func (r *databaseRepository) GetImportedTaskIDs(ctx context.Context, userID string, rowHashes []string) (map[string]string, error) {
+	var imports []models.TaskImport
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND row_hash IN ?", userID, rowHashes).
+		Find(&imports).Error; err != nil {
+		return nil, err
+	}
+
+	taskIDs := make(map[string]string, len(imports))
+	for _, i := range imports {
+		taskIDs[i.RowHash] = i.TaskID.String()
+	}
+
+	return taskIDs, nil
+}
+
+func (r *databaseRepository) CreateImportedTasks(ctx context.Context, tasks []*entities.Task, rowHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, task := range tasks {
+			t, err := r.mapper.TaskToModel(task)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Create(t).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Create(&models.TaskImport{
+				UserID:  t.UserID,
+				RowHash: rowHashes[i],
+				TaskID:  t.ID,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SaveCalendarFeed creates the user's feed or replaces the existing one.
+func (r *databaseRepository) SaveCalendarFeed(ctx context.Context, feed *entities.CalendarFeed) error {
+	f, err := r.mapper.CalendarFeedToModel(feed)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(f).Error
+}
+
+func (r *databaseRepository) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error) {
+	var f models.CalendarFeed
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&f).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.CalendarFeedToDomain(&f), nil
+}
+
+func (r *databaseRepository) DeleteCalendarFeed(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.CalendarFeed{}).Error
+}
+
+// GetTasksByIDs returns the tasks whatever user they belong to,
+// callers check the user may see them.
+func (r *databaseRepository) GetTasksByIDs(ctx context.Context, IDs []string) ([]*entities.Task, error) {
+	var t []models.Task
+	if err := r.db.WithContext(ctx).Where("id IN ?", IDs).Find(&t).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*entities.Task, 0, len(t))
+	for _, task := range t {
+		tasks = append(tasks, r.mapper.TaskToDomain(&task))
+	}
+
+	return tasks, nil
+}
+
+// GetTaskCollaborator returns the user's membership of the task, nil if it isn't shared with them.
+func (r *databaseRepository) GetTaskCollaborator(ctx context.Context, taskID, userID string) (*entities.TaskCollaborator, error) {
+	var collaborators []models.TaskCollaborator
+	if err := r.db.WithContext(ctx).Where("task_id = ? AND user_id = ?", taskID, userID).
+		Limit(1).Find(&collaborators).Error; err != nil {
+		return nil, err
+	}
+
+	if len(collaborators) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.TaskCollaboratorToDomain(&collaborators[0], ""), nil
+}
+
+// GetTaskCollaborators returns the users the task is shared with, in the order it was shared.
+func (r *databaseRepository) GetTaskCollaborators(ctx context.Context, taskID string) ([]*entities.TaskCollaborator, error) {
+	var rows []struct {
+		models.TaskCollaborator
+		Username string
+	}
+	if err := r.db.WithContext(ctx).Model(&models.TaskCollaborator{}).
+		Select("task_collaborators.*, users.username").
+		Joins("JOIN users ON users.id = task_collaborators.user_id").
+		Where("task_collaborators.task_id = ?", taskID).
+		Order("task_collaborators.created_at, users.username").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]*entities.TaskCollaborator, 0, len(rows))
+	for _, row := range rows {
+		collaborators = append(collaborators, r.mapper.TaskCollaboratorToDomain(&row.TaskCollaborator, row.Username))
+	}
+
+	return collaborators, nil
+}
+
+// SaveTaskCollaborator shares the task with the user or changes their role.
+func (r *databaseRepository) SaveTaskCollaborator(ctx context.Context, collaborator *entities.TaskCollaborator) error {
+	c, err := r.mapper.TaskCollaboratorToModel(collaborator)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(c).Error
+}
+
+func (r *databaseRepository) DeleteTaskCollaborator(ctx context.Context, taskID, userID string) error {
+	return r.db.WithContext(ctx).Where("task_id = ? AND user_id = ?", taskID, userID).
+		Delete(&models.TaskCollaborator{}).Error
+}
+
+// GetRunningTimeEntry returns the user's running timer, nil if no timer runs.
+func (r *databaseRepository) GetRunningTimeEntry(ctx context.Context, userID string) (*entities.TimeEntry, error) {
+	var entries []models.TimeEntry
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND stopped_at = 0", userID).
+		Limit(1).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.TimeEntryToDomain(&entries[0]), nil
+}
+
+// SaveTimeEntries creates or updates the entries in one transaction,
+// so stopping a timer and starting the next one can't leave two running.
+func (r *databaseRepository) SaveTimeEntries(ctx context.Context, entries ...*entities.TimeEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			e, err := r.mapper.TimeEntryToModel(entry)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Save(e).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetTimeEntries returns the entries overlapping the query range, newest first.
+// Ranges with more entries than the query's limit fail rather than leave some out.
+func (r *databaseRepository) GetTimeEntries(ctx context.Context, query *valueobjects.TimeEntriesQuery) ([]*entities.TimeEntry, error) {
+	q := r.db.WithContext(ctx).
+		Where("user_id = ? AND started_at <= ? AND (stopped_at = 0 OR stopped_at >= ?)",
+			query.UserID(), query.To().Unix(), query.From().Unix())
+	q = q.Where("task_id IN (?)", r.db.Model(&models.Task{}).Select("id").Where("workspace_id = ?", query.WorkspaceID()))
+	if query.TaskID() != "" {
+		q = q.Where("task_id = ?", query.TaskID())
+	}
+
+	var e []models.TimeEntry
+	if err := q.Order("started_at DESC, id").Limit(query.Limit() + 1).Find(&e).Error; err != nil {
+		return nil, err
+	}
+
+	if len(e) > query.Limit() {
+		return nil, errors.ErrTooManyTimeEntries
+	}
+
+	entries := make([]*entities.TimeEntry, 0, len(e))
+	for _, entry := range e {
+		entries = append(entries, r.mapper.TimeEntryToDomain(&entry))
+	}
+
+	return entries, nil
+}
+
+// GetTrackedSeconds sums the time entries of the tasks, running timers count until now.
+// Tasks without entries are missing from the result.
+func (r *databaseRepository) GetTrackedSeconds(ctx context.Context, taskIDs []string, now int64) (map[string]int64, error) {
+	tracked := make(map[string]int64, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return tracked, nil
+	}
+
+	var rows []struct {
+		TaskID  uuid.UUID
+		Seconds int64
+	}
+	if err := r.db.WithContext(ctx).Model(&models.TimeEntry{}).
+		Select("task_id, SUM(CASE WHEN stopped_at = 0 THEN GREATEST(? - started_at, 0) ELSE stopped_at - started_at END) AS seconds", now).
+		Where("task_id IN ?", taskIDs).
+		Group("task_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tracked[row.TaskID.String()] = row.Seconds
+	}
+
+	return tracked, nil
+}
+
+// GetWorkflow returns the user's workflow, the default one if the user has no statuses.
+func (r *databaseRepository) GetWorkflow(ctx context.Context, userID string) (*entities.Workflow, error) {
+	var statuses []models.WorkflowStatus
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Order("position").Find(&statuses).Error; err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return entities.DefaultWorkflow(userID), nil
+	}
+
+	var transitions []models.WorkflowTransition
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.WorkflowToDomain(userID, statuses, transitions), nil
+}
+
+// SaveWorkflow replaces the user's workflow and moves the category of the user's tasks
+// along with their statuses. Saving the default workflow removes the user's own statuses.
+// It fails with ErrStatusInUse if tasks are in a status the workflow no longer has.
+func (r *databaseRepository) SaveWorkflow(ctx context.Context, workflow *entities.Workflow) error {
+	statuses, transitions, err := r.mapper.WorkflowToModel(workflow)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]uint8, 0, len(workflow.Statuses()))
+		for _, status := range workflow.Statuses() {
+			ids = append(ids, uint8(status.ID))
+		}
+
+		var inUse int64
+		if err := tx.Model(&models.Task{}).
+			Where("user_id = ? AND status NOT IN ?", workflow.UserID(), ids).
+			Count(&inUse).Error; err != nil {
+			return err
+		}
+		if inUse > 0 {
+			return errors.ErrStatusInUse
+		}
+
+		if err := tx.Where("user_id = ?", workflow.UserID()).Delete(&models.WorkflowTransition{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", workflow.UserID()).Delete(&models.WorkflowStatus{}).Error; err != nil {
+			return err
+		}
+
+		if !workflow.IsDefault() {
+			if err := tx.Create(&statuses).Error; err != nil {
+				return err
+			}
+			if len(transitions) > 0 {
+				if err := tx.Create(&transitions).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, status := range workflow.Statuses() {
+			if err := tx.Model(&models.Task{}).
+				Where("user_id = ? AND status = ? AND status_category <> ?", workflow.UserID(), status.ID, status.Category).
+				UpdateColumn("status_category", status.Category).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetTaskStats computes the stats with aggregates, completions are bucketed
+// by date_trunc in the query location.
+func (r *databaseRepository) GetTaskStats(ctx context.Context, query *valueobjects.TaskStatsQuery) (*valueobjects.TaskStats, error) {
+	db := r.db.WithContext(ctx)
+	tasks := func() *gorm.DB {
+		return db.Model(&models.Task{}).Where("user_id = ? AND workspace_id = ?", query.UserID(), query.WorkspaceID())
+	}
+
+	stats := valueobjects.TaskStats{
+		OpenByStatus:   make(map[valueobjects.TaskStatus]int64),
+		OpenByPriority: make(map[valueobjects.TaskPriority]int64),
+	}
+
+	var byStatus []struct {
+		Status uint8
+		Count  int64
+	}
+	if err := tasks().Select("status, COUNT(*) AS count").
+		Where("status_category <> ?", valueobjects.StatusCategoryDone).
+		Group("status").Scan(&byStatus).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range byStatus {
+		stats.OpenByStatus[valueobjects.TaskStatus(row.Status)] = row.Count
+	}
+
+	var byPriority []struct {
+		Priority uint8
+		Count    int64
+	}
+	if err := tasks().Select("priority, COUNT(*) AS count").
+		Where("status_category <> ?", valueobjects.StatusCategoryDone).
+		Group("priority").Scan(&byPriority).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range byPriority {
+		stats.OpenByPriority[valueobjects.TaskPriority(row.Priority)] = row.Count
+	}
+
+	if err := whereOverdue(tasks(), query.Location()).Count(&stats.OverdueCount).Error; err != nil {
+		return nil, err
+	}
+
+	var remaining struct {
+		EstimateMinutes int64
+		StoryPoints     int64
+	}
+	if err := tasks().Select("COALESCE(SUM(estimate_minutes), 0) AS estimate_minutes, COALESCE(SUM(story_points), 0) AS story_points").
+		Where("status_category <> ?", valueobjects.StatusCategoryDone).
+		Scan(&remaining).Error; err != nil {
+		return nil, err
+	}
+	stats.OpenEstimateMinutes = remaining.EstimateMinutes
+	stats.OpenStoryPoints = remaining.StoryPoints
+
+	completed := func() *gorm.DB {
+		return tasks().Where("status_category = ? AND completed_at BETWEEN ? AND ?",
+			valueobjects.StatusCategoryDone, query.From().Unix(), query.To().Unix())
+	}
+
+	var totals struct {
+		Count        int64
+		AvgLeadTime  float64
+		AvgCycleTime float64
+		StoryPoints  int64
+	}
+	if err := completed().Select("COUNT(*) AS count, " +
+		"COALESCE(AVG(completed_at - created_at), 0) AS avg_lead_time, " +
+		"COALESCE(AVG(completed_at - started_at) FILTER (WHERE started_at > 0), 0) AS avg_cycle_time, " +
+		"COALESCE(SUM(story_points), 0) AS story_points").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+	stats.CompletedCount = totals.Count
+	stats.CompletedStoryPoints = totals.StoryPoints
+	stats.AvgCompletionSeconds = int64(totals.AvgLeadTime)
+	stats.AvgCycleTimeSeconds = int64(totals.AvgCycleTime)
+
+	// to_timestamp is timestamptz, AT TIME ZONE turns it into local time for date_trunc and back
+	tz := query.Location().String()
+	if err := completed().
+		Select("EXTRACT(EPOCH FROM date_trunc(?, to_timestamp(completed_at) AT TIME ZONE ?) AT TIME ZONE ?)::bigint AS start, COUNT(*) AS count",
+			string(query.Bucket()), tz, tz).
+		Group("1").Order("1").Scan(&stats.Completed).Error; err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// sortColumns maps sort fields to SQL expressions.
+// Tasks store 0 when there is no due date, completion time, estimate or story points, NULLIF turns it into NULL
+// so NULLS FIRST/LAST applies to them.
+var sortColumns = map[valueobjects.SortField]string{
+	valueobjects.SortByPriority:    "priority",
+	valueobjects.SortByDueDate:     "NULLIF(due_date, 0)",
+	valueobjects.SortByCreatedAt:   "created_at",
+	valueobjects.SortByTitle:       `title COLLATE "und-x-icu"`, // ICU root collation, locale aware and not byte order
+	valueobjects.SortByStatus:      "status",
+	valueobjects.SortByUpdatedAt:   "updated_at",
+	valueobjects.SortByCompletedAt: "NULLIF(completed_at, 0)",
+	valueobjects.SortByEstimate:    "NULLIF(estimate_minutes, 0)",
+	valueobjects.SortByStoryPoints: "NULLIF(story_points, 0)",
+	valueobjects.SortByManual:      `rank COLLATE "C"`, // ranks compare byte-wise
+}
+
+// whereOverdue matches open tasks past their due date. Tasks with a due time are overdue
+// after it, all-day tasks once their date is over in loc.
+func whereOverdue(q *gorm.DB, loc *time.Location) *gorm.DB {
+	now := time.Now()
+	return q.Where("due_date > 0 AND status_category <> ? AND due_date < CASE WHEN all_day THEN ? ELSE ? END",
+		valueobjects.StatusCategoryDone, valueobjects.DateOf(now.In(loc)), now.Unix())
+}
+
+// applyFilters compares the due date bounds with the date of all-day tasks in the filters' location.
+func applyFilters(q *gorm.DB, filters valueobjects.TaskFilters) *gorm.DB {
+	loc := filters.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	if len(filters.Statuses) > 0 {
+		q = q.Where("status IN ?", filters.Statuses)
+	}
+
+	if len(filters.Priorities) > 0 {
+		q = q.Where("priority IN ?", filters.Priorities)
+	}
+
+	if filters.DueAfter != nil {
+		q = q.Where("due_date >= CASE WHEN all_day THEN ? ELSE ? END",
+			valueobjects.DateOf(filters.DueAfter.In(loc)), filters.DueAfter.Unix())
+	}
+
+	if filters.DueBefore != nil {
+		q = q.Where("due_date > 0 AND due_date <= CASE WHEN all_day THEN ? ELSE ? END",
+			valueobjects.DateOf(filters.DueBefore.In(loc)), filters.DueBefore.Unix())
+	}
+
+	if filters.CreatedAfter != nil {
+		q = q.Where("created_at >= ?", filters.CreatedAfter.Unix())
+	}
+
+	if filters.CreatedBefore != nil {
+		q = q.Where("created_at <= ?", filters.CreatedBefore.Unix())
+	}
+
+	if filters.OverdueOnly {
+		q = whereOverdue(q, loc)
+	}
+
+	if filters.HasDueDate != nil {
+		if *filters.HasDueDate {
+			q = q.Where("due_date > 0")
+		} else {
+			q = q.Where("due_date = 0")
+		}
+	}
+
+	if filters.HasEstimate != nil {
+		if *filters.HasEstimate {
+			q = q.Where("estimate_minutes > 0")
+		} else {
+			q = q.Where("estimate_minutes = 0")
+		}
+	}
+
+	if filters.MaxEstimate > 0 {
+		q = q.Where("estimate_minutes > 0 AND estimate_minutes <= ?", filters.MaxEstimate)
+	}
+
+	if len(filters.StoryPoints) > 0 {
+		q = q.Where("story_points IN ?", filters.StoryPoints)
+	}
+
+	if filters.AssigneeID != "" {
+		q = q.Where("assignee_id = ?", filters.AssigneeID)
+	}
+
+	return q
+}
+
+// CreateWorkspace creates the workspace with its owner as the first member.
+func (r *databaseRepository) CreateWorkspace(ctx context.Context, workspace *entities.Workspace, owner *entities.Membership) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.createWorkspace(tx, workspace, owner)
+	})
+}
+
+func (r *databaseRepository) createWorkspace(tx *gorm.DB, workspace *entities.Workspace, owner *entities.Membership) error {
+	w, err := r.mapper.WorkspaceToModel(workspace)
+	if err != nil {
+		return err
+	}
+
+	m, err := r.mapper.MembershipToModel(owner)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Create(w).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(m).Error
+}
+
+func (r *databaseRepository) GetWorkspace(ctx context.Context, ID string) (*entities.Workspace, error) {
+	var w models.Workspace
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&w).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.WorkspaceToDomain(&w), nil
+}
+
+// GetMembership returns the user's membership of the workspace, nil if they aren't a member.
+func (r *databaseRepository) GetMembership(ctx context.Context, workspaceID, userID string) (*entities.Membership, error) {
+	var rows []struct {
+		models.Membership
+		Name string
+	}
+	if err := r.db.WithContext(ctx).Model(&models.Membership{}).
+		Select("memberships.*, workspaces.name").
+		Joins("JOIN workspaces ON workspaces.id = memberships.workspace_id").
+		Where("memberships.workspace_id = ? AND memberships.user_id = ?", workspaceID, userID).
+		Limit(1).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.MembershipToDomain(&rows[0].Membership, rows[0].Name), nil
+}
+
+// GetUserMemberships returns the workspaces the user is a member of, in the order they joined.
+func (r *databaseRepository) GetUserMemberships(ctx context.Context, userID string) ([]*entities.Membership, error) {
+	var rows []struct {
+		models.Membership
+		Name string
+	}
+	if err := r.db.WithContext(ctx).Model(&models.Membership{}).
+		Select("memberships.*, workspaces.name").
+		Joins("JOIN workspaces ON workspaces.id = memberships.workspace_id").
+		Where("memberships.user_id = ?", userID).
+		Order("memberships.created_at, workspaces.name").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	memberships := make([]*entities.Membership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, r.mapper.MembershipToDomain(&row.Membership, row.Name))
+	}
+
+	return memberships, nil
+}
+
+// DeleteMembership removes the user from the workspace together with their access
+// to tasks of the workspace shared with or assigned to them. Their own tasks stay in the workspace.
+func (r *databaseRepository) DeleteMembership(ctx context.Context, workspaceID, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND task_id IN (?)", userID,
+			tx.Model(&models.Task{}).Select("id").Where("workspace_id = ?", workspaceID)).
+			Delete(&models.TaskCollaborator{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Task{}).Where("workspace_id = ? AND assignee_id = ?", workspaceID, userID).
+			UpdateColumn("assignee_id", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+			Delete(&models.Membership{}).Error
+	})
+}
+
+// SaveWorkspaceInvitation invites the user or replaces their pending invitation to the workspace.
+func (r *databaseRepository) SaveWorkspaceInvitation(ctx context.Context, invitation *entities.WorkspaceInvitation) error {
+	i, err := r.mapper.WorkspaceInvitationToModel(invitation)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"id", "invited_by", "role", "created_at"}),
+	}).Create(i).Error
+}
+
+func (r *databaseRepository) GetWorkspaceInvitation(ctx context.Context, ID string) (*entities.WorkspaceInvitation, error) {
+	var rows []struct {
+		models.WorkspaceInvitation
+		Name string
+	}
+	if err := r.db.WithContext(ctx).Model(&models.WorkspaceInvitation{}).
+		Select("workspace_invitations.*, workspaces.name").
+		Joins("JOIN workspaces ON workspaces.id = workspace_invitations.workspace_id").
+		Where("workspace_invitations.id = ?", ID).
+		Limit(1).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return r.mapper.WorkspaceInvitationToDomain(&rows[0].WorkspaceInvitation, rows[0].Name), nil
+}
+
+// GetUserWorkspaceInvitations returns the user's pending invitations, newest first.
+func (r *databaseRepository) GetUserWorkspaceInvitations(ctx context.Context, userID string) ([]*entities.WorkspaceInvitation, error) {
+	var rows []struct {
+		models.WorkspaceInvitation
+		Name string
+	}
+	if err := r.db.WithContext(ctx).Model(&models.WorkspaceInvitation{}).
+		Select("workspace_invitations.*, workspaces.name").
+		Joins("JOIN workspaces ON workspaces.id = workspace_invitations.workspace_id").
+		Where("workspace_invitations.user_id = ?", userID).
+		Order("workspace_invitations.created_at DESC, workspace_invitations.id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*entities.WorkspaceInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, r.mapper.WorkspaceInvitationToDomain(&row.WorkspaceInvitation, row.Name))
+	}
+
+	return invitations, nil
+}
+
+// AcceptWorkspaceInvitation makes the invited user a member and removes the invitation.
+func (r *databaseRepository) AcceptWorkspaceInvitation(ctx context.Context, invitation *entities.WorkspaceInvitation,
+	membership *entities.Membership) error {
+	m, err := r.mapper.MembershipToModel(membership)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", invitation.ID()).Delete(&models.WorkspaceInvitation{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(m).Error
+	})
+}
+
+func (r *databaseRepository) DeleteWorkspaceInvitation(ctx context.Context, ID string) error {
+	return r.db.WithContext(ctx).Where("id = ?", ID).Delete(&models.WorkspaceInvitation{}).Error
+}
+
+func (r *databaseRepository) Transaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&databaseRepository{
+			db:     tx,
+			mapper: r.mapper,
+		})
+	})
+}
+
+// RecordTaskCommand adds the command to the undo log of its user in its workspace.
+// It drops the undone commands, which can't be redone after a new one, the commands
+// created before notBefore and the oldest ones beyond depth.
+func (r *databaseRepository) RecordTaskCommand(ctx context.Context, command *entities.TaskCommand, depth int, notBefore int64) error {
+	c, err := r.mapper.TaskCommandToModel(command)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		commands := func() *gorm.DB {
+			return tx.Model(&models.TaskCommand{}).Where("user_id = ? AND workspace_id = ?", c.UserID, c.WorkspaceID)
+		}
+
+		if err := commands().Where("undone OR created_at < ?", notBefore).
+			Delete(&models.TaskCommand{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+
+		return commands().Where("id NOT IN (?)", commands().Select("id").Order("created_at DESC").Limit(depth)).
+			Delete(&models.TaskCommand{}).Error
+	})
+}
+
+// GetLastTaskCommand returns the command of the user's log in the workspace that is undone next,
+// or with undone the one redone next, created since notBefore. It returns nil if there is none.
+func (r *databaseRepository) GetLastTaskCommand(ctx context.Context, userID, workspaceID string,
+	undone bool, notBefore int64) (*entities.TaskCommand, error) {
+	// undoing walks the log back, so the earliest undone command is the one undone last
+	order := "created_at DESC"
+	if undone {
+		order = "created_at"
+	}
+
+	var c []models.TaskCommand
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND workspace_id = ? AND undone = ? AND created_at >= ?", userID, workspaceID, undone, notBefore).
+		Order(order).Limit(1).Find(&c).Error; err != nil {
+		return nil, err
+	}
+
+	if len(c) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.TaskCommandToDomain(&c[0])
+}
+
+// ReplayTaskCommand brings the tasks of the command to its current side, see TaskCommand.Steps,
+// and saves whether it's undone. If a task isn't in the state the command expects it
+// fails with ErrTaskChanged and changes nothing.
+func (r *databaseRepository) ReplayTaskCommand(ctx context.Context, command *entities.TaskCommand) error {
+	steps := command.Steps()
+	IDs := make([]string, 0, len(steps))
+	for _, step := range steps {
+		IDs = append(IDs, step.TaskID())
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []models.Task
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", IDs).Find(&rows).Error; err != nil {
+			return err
+		}
+
+		current := make(map[string]*entities.Task, len(rows))
+		for i := range rows {
+			current[rows[i].ID.String()] = r.mapper.TaskToDomain(&rows[i])
+		}
+
+		for _, step := range steps {
+			task, exists := current[step.TaskID()]
+			if exists != (step.Before() != nil) || (exists && !task.Equal(step.Before())) {
+				return errors.ErrTaskChanged
+			}
+		}
+
+		for _, step := range steps {
+			if step.After() == nil {
+				if err := tx.Where("id = ?", step.TaskID()).Delete(&models.Task{}).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			t, err := r.mapper.TaskToModel(step.After())
+			if err != nil {
+				return err
+			}
+
+			save := tx.Save
+			if step.Before() == nil {
+				save = tx.Create
+			}
+			if err := save(t).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.TaskCommand{}).Where("id = ?", command.ID()).
+			Update("undone", command.Undone()).Error
+	})
+}
+
+func (r *databaseRepository) SaveTaskTemplate(ctx context.Context, template *entities.TaskTemplate) error {
+	t, err := r.mapper.TaskTemplateToModel(template)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(t).Error
+}
+
+func (r *databaseRepository) GetTaskTemplate(ctx context.Context, ID string) (*entities.TaskTemplate, error) {
+	var t models.TaskTemplate
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&t).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.TaskTemplateToDomain(&t), nil
+}
+
+// GetUserTaskTemplates returns the user's templates by name.
+func (r *databaseRepository) GetUserTaskTemplates(ctx context.Context, userID string) ([]*entities.TaskTemplate, error) {
+	var t []models.TaskTemplate
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Order("name").Order("id").Find(&t).Error; err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entities.TaskTemplate, 0, len(t))
+	for i := range t {
+		templates = append(templates, r.mapper.TaskTemplateToDomain(&t[i]))
+	}
+
+	return templates, nil
+}
+
+func (r *databaseRepository) DeleteTaskTemplate(ctx context.Context, ID string) error {
+	return r.db.WithContext(ctx).Where("id = ?", ID).Delete(&models.TaskTemplate{}).Error
+}
+
+// CreateSession creates the session with its first refresh token
+// and deletes the user's sessions that have expired by then.
+func (r *databaseRepository) CreateSession(ctx context.Context, session *entities.Session, token *entities.RefreshToken) error {
+	s, err := r.mapper.SessionToModel(session)
+	if err != nil {
+		return err
+	}
+
+	t, err := r.mapper.RefreshTokenToModel(token)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND expires_at <= ?", s.UserID, s.CreatedAt).
+			Delete(&models.Session{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(s).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(t).Error
+	})
+}
+
+// GetSession returns the session, nil if it doesn't exist.
+func (r *databaseRepository) GetSession(ctx context.Context, ID string) (*entities.Session, error) {
+	var s []models.Session
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).Limit(1).Find(&s).Error; err != nil {
+		return nil, err
+	}
+
+	if len(s) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.SessionToDomain(&s[0]), nil
+}
+
+// GetUserSessions returns the user's sessions that haven't expired at now, the last refreshed first.
+func (r *databaseRepository) GetUserSessions(ctx context.Context, userID string, now int64) ([]*entities.Session, error) {
+	var s []models.Session
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND expires_at > ?", userID, now).
+		Order("last_refreshed_at DESC").Order("id").Find(&s).Error; err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*entities.Session, 0, len(s))
+	for i := range s {
+		sessions = append(sessions, r.mapper.SessionToDomain(&s[i]))
+	}
+
+	return sessions, nil
+}
+
+func (r *databaseRepository) SaveSession(ctx context.Context, session *entities.Session) error {
+	s, err := r.mapper.SessionToModel(session)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(s).Error
+}
+
+// GetRefreshToken returns the token by its hash, nil if it doesn't exist.
+func (r *databaseRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	var t []models.RefreshToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).Limit(1).Find(&t).Error; err != nil {
+		return nil, err
+	}
+
+	if len(t) == 0 {
+		return nil, nil
+	}
+
+	return r.mapper.RefreshTokenToDomain(&t[0]), nil
+}
+
+// RotateRefreshToken marks the used token, saves the session and creates the next token at once.
+// A token is used only once, if a concurrent rotation used it first it returns errors.ErrInvalidRefreshToken.
+func (r *databaseRepository) RotateRefreshToken(ctx context.Context, used *entities.RefreshToken,
+	session *entities.Session, next *entities.RefreshToken) error {
+	s, err := r.mapper.SessionToModel(session)
+	if err != nil {
+		return err
+	}
+
+	t, err := r.mapper.RefreshTokenToModel(next)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&models.RefreshToken{}).
+			Where("token_hash = ? AND used_at = 0", used.TokenHash()).
+			Update("used_at", used.UsedAt())
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return errors.ErrInvalidRefreshToken
+		}
+
+		if err := tx.Save(s).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(t).Error
+	})
+}
+
+// DeleteSession deletes the session with its refresh tokens.
+func (r *databaseRepository) DeleteSession(ctx context.Context, ID string) error {
+	return r.db.WithContext(ctx).Where("id = ?", ID).Delete(&models.Session{}).Error
+}
+
+// DeleteUserSessions deletes all sessions of the user with their refresh tokens.
+func (r *databaseRepository) DeleteUserSessions(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.Session{}).Error
+}
@@ -1,7 +1,11 @@
 package database
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/braunkc/todo-app/database-service/internal/domain/entities"
+	valueobjects "github.com/braunkc/todo-app/database-service/internal/domain/value_objects/task"
 	"github.com/braunkc/todo-app/database-service/internal/infra/database/postgres/models"
 	"github.com/google/uuid"
 )
@@ -13,6 +17,29 @@ type Mapper interface {
 	UserToDomain(user *models.User) *entities.User
 	TaskToModel(task *entities.Task) (*models.Task, error)
 	TaskToDomain(task *models.Task) *entities.Task
+	CalendarFeedToModel(feed *entities.CalendarFeed) (*models.CalendarFeed, error)
+	CalendarFeedToDomain(feed *models.CalendarFeed) *entities.CalendarFeed
+	WorkflowToModel(workflow *entities.Workflow) ([]models.WorkflowStatus, []models.WorkflowTransition, error)
+	WorkflowToDomain(userID string, statuses []models.WorkflowStatus, transitions []models.WorkflowTransition) *entities.Workflow
+	TimeEntryToModel(entry *entities.TimeEntry) (*models.TimeEntry, error)
+	TimeEntryToDomain(entry *models.TimeEntry) *entities.TimeEntry
+	TaskTemplateToModel(template *entities.TaskTemplate) (*models.TaskTemplate, error)
+	TaskTemplateToDomain(template *models.TaskTemplate) *entities.TaskTemplate
+	TaskCommandToModel(command *entities.TaskCommand) (*models.TaskCommand, error)
+	TaskCommandToDomain(command *models.TaskCommand) (*entities.TaskCommand, error)
+	TaskAssignmentToModel(assignment *entities.TaskAssignment) (*models.TaskAssignment, error)
+	TaskCollaboratorToModel(collaborator *entities.TaskCollaborator) (*models.TaskCollaborator, error)
+	TaskCollaboratorToDomain(collaborator *models.TaskCollaborator, username string) *entities.TaskCollaborator
+	WorkspaceToModel(workspace *entities.Workspace) (*models.Workspace, error)
+	WorkspaceToDomain(workspace *models.Workspace) *entities.Workspace
+	MembershipToModel(membership *entities.Membership) (*models.Membership, error)
+	MembershipToDomain(membership *models.Membership, workspaceName string) *entities.Membership
+	WorkspaceInvitationToModel(invitation *entities.WorkspaceInvitation) (*models.WorkspaceInvitation, error)
+	WorkspaceInvitationToDomain(invitation *models.WorkspaceInvitation, workspaceName string) *entities.WorkspaceInvitation
+	SessionToModel(session *entities.Session) (*models.Session, error)
+	SessionToDomain(session *models.Session) *entities.Session
+	RefreshTokenToModel(token *entities.RefreshToken) (*models.RefreshToken, error)
+	RefreshTokenToDomain(token *models.RefreshToken) *entities.RefreshToken
 }
 
 func NewMapper() Mapper {
@@ -29,11 +56,12 @@ func (r *mapper) UserToModel(user *entities.User) (*models.User, error) {
 		ID:           id,
 		Username:     user.Username(),
 		PasswordHash: user.PasswordHash(),
+		Timezone:     user.Timezone(),
 	}, nil
 }
 
 func (r *mapper) UserToDomain(user *models.User) *entities.User {
-	return entities.NewUserFromStorage(user.ID.String(), user.Username, user.PasswordHash)
+	return entities.NewUserFromStorage(user.ID.String(), user.Username, user.PasswordHash, user.Timezone)
 }
 
 func (r *mapper) TaskToModel(task *entities.Task) (*models.Task, error) {
@@ -45,20 +73,478 @@ func (r *mapper) TaskToModel(task *entities.Task) (*models.Task, error) {
 	if err != nil {
 		return nil, err
 	}
+	workspaceID, err := uuid.Parse(task.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+	assigneeID, err := optionalUUID(task.AssigneeID())
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := optionalUUID(task.ParentID())
+	if err != nil {
+		return nil, err
+	}
 
 	return &models.Task{
+		ID:              id,
+		UserID:          userID,
+		WorkspaceID:     workspaceID,
+		AssigneeID:      assigneeID,
+		ParentID:        parentID,
+		Title:           task.Title(),
+		Description:     task.Description(),
+		Status:          task.Status(),
+		StatusCategory:  task.Category(),
+		Priority:        task.Priority(),
+		DueDate:         task.DueDate(),
+		Project:         task.Project(),
+		AllDay:          task.AllDay(),
+		EstimateMinutes: task.EstimateMinutes(),
+		StoryPoints:     task.StoryPoints(),
+		Rank:            task.Rank(),
+		Tags:            strings.Join(task.Tags(), ","),
+		CreatedAt:       task.CreatedAt(),
+		UpdatedAt:       task.UpdatedAt(),
+		StartedAt:       task.StartedAt(),
+		CompletedAt:     task.CompletedAt(),
+	}, nil
+}
+
+func (r *mapper) TaskToDomain(task *models.Task) *entities.Task {
+	var assigneeID, parentID string
+	if task.AssigneeID != nil {
+		assigneeID = task.AssigneeID.String()
+	}
+	if task.ParentID != nil {
+		parentID = task.ParentID.String()
+	}
+
+	return entities.NewTaskFromStorage(task.ID.String(), task.UserID.String(), task.WorkspaceID.String(), assigneeID, parentID,
+		task.Title, task.Description, task.Status, task.StatusCategory, task.Priority, task.DueDate, task.AllDay, task.Project,
+		task.EstimateMinutes, task.StoryPoints, task.Rank, task.Tags, task.CreatedAt, task.UpdatedAt, task.StartedAt, task.CompletedAt)
+}
+
+func (r *mapper) CalendarFeedToModel(feed *entities.CalendarFeed) (*models.CalendarFeed, error) {
+	userID, err := uuid.Parse(feed.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CalendarFeed{
+		UserID:    userID,
+		TokenHash: feed.TokenHash(),
+		CreatedAt: feed.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) CalendarFeedToDomain(feed *models.CalendarFeed) *entities.CalendarFeed {
+	return entities.NewCalendarFeedFromStorage(feed.UserID.String(), feed.TokenHash, feed.CreatedAt)
+}
+
+func (r *mapper) WorkflowToModel(workflow *entities.Workflow) ([]models.WorkflowStatus, []models.WorkflowTransition, error) {
+	userID, err := uuid.Parse(workflow.UserID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := make([]models.WorkflowStatus, 0, len(workflow.Statuses()))
+	for i, status := range workflow.Statuses() {
+		statuses = append(statuses, models.WorkflowStatus{
+			UserID:   userID,
+			ID:       uint8(status.ID),
+			Name:     status.Name,
+			Category: uint8(status.Category),
+			Position: i,
+		})
+	}
+
+	transitions := make([]models.WorkflowTransition, 0, len(workflow.Transitions()))
+	for _, transition := range workflow.Transitions() {
+		transitions = append(transitions, models.WorkflowTransition{
+			UserID: userID,
+			FromID: uint8(transition.From),
+			ToID:   uint8(transition.To),
+		})
+	}
+
+	return statuses, transitions, nil
+}
+
+// WorkflowToDomain expects the statuses ordered by position.
+func (r *mapper) WorkflowToDomain(userID string, statuses []models.WorkflowStatus, transitions []models.WorkflowTransition) *entities.Workflow {
+	s := make([]entities.WorkflowStatus, 0, len(statuses))
+	for _, status := range statuses {
+		s = append(s, entities.WorkflowStatus{
+			ID:       valueobjects.TaskStatus(status.ID),
+			Name:     status.Name,
+			Category: valueobjects.StatusCategory(status.Category),
+		})
+	}
+
+	t := make([]entities.WorkflowTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		t = append(t, entities.WorkflowTransition{
+			From: valueobjects.TaskStatus(transition.FromID),
+			To:   valueobjects.TaskStatus(transition.ToID),
+		})
+	}
+
+	return entities.NewWorkflowFromStorage(userID, s, t)
+}
+
+func (r *mapper) TimeEntryToModel(entry *entities.TimeEntry) (*models.TimeEntry, error) {
+	id, err := uuid.Parse(entry.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(entry.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := uuid.Parse(entry.TaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TimeEntry{
+		ID:        id,
+		UserID:    userID,
+		TaskID:    taskID,
+		Project:   entry.Project(),
+		StartedAt: entry.StartedAt(),
+		StoppedAt: entry.StoppedAt(),
+	}, nil
+}
+
+func (r *mapper) TimeEntryToDomain(entry *models.TimeEntry) *entities.TimeEntry {
+	return entities.NewTimeEntryFromStorage(entry.ID.String(), entry.UserID.String(), entry.TaskID.String(),
+		entry.Project, entry.StartedAt, entry.StoppedAt)
+}
+
+func (r *mapper) TaskCollaboratorToModel(collaborator *entities.TaskCollaborator) (*models.TaskCollaborator, error) {
+	taskID, err := uuid.Parse(collaborator.TaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(collaborator.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TaskCollaborator{
+		TaskID:    taskID,
+		UserID:    userID,
+		Role:      uint8(collaborator.Role()),
+		CreatedAt: collaborator.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) TaskCollaboratorToDomain(collaborator *models.TaskCollaborator, username string) *entities.TaskCollaborator {
+	return entities.NewTaskCollaboratorFromStorage(collaborator.TaskID.String(), collaborator.UserID.String(),
+		username, collaborator.Role, collaborator.CreatedAt)
+}
+
+func (r *mapper) WorkspaceToModel(workspace *entities.Workspace) (*models.Workspace, error) {
+	id, err := uuid.Parse(workspace.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Workspace{
+		ID:        id,
+		Name:      workspace.Name(),
+		CreatedAt: workspace.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) WorkspaceToDomain(workspace *models.Workspace) *entities.Workspace {
+	return entities.NewWorkspaceFromStorage(workspace.ID.String(), workspace.Name, workspace.CreatedAt)
+}
+
+func (r *mapper) MembershipToModel(membership *entities.Membership) (*models.Membership, error) {
+	workspaceID, err := uuid.Parse(membership.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(membership.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Membership{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		Role:        uint8(membership.Role()),
+		CreatedAt:   membership.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) MembershipToDomain(membership *models.Membership, workspaceName string) *entities.Membership {
+	return entities.NewMembershipFromStorage(membership.WorkspaceID.String(), workspaceName,
+		membership.UserID.String(), membership.Role, membership.CreatedAt)
+}
+
+func (r *mapper) WorkspaceInvitationToModel(invitation *entities.WorkspaceInvitation) (*models.WorkspaceInvitation, error) {
+	id, err := uuid.Parse(invitation.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := uuid.Parse(invitation.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(invitation.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	invitedBy, err := uuid.Parse(invitation.InvitedBy())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.WorkspaceInvitation{
+		ID:          id,
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		InvitedBy:   invitedBy,
+		Role:        uint8(invitation.Role()),
+		CreatedAt:   invitation.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) WorkspaceInvitationToDomain(invitation *models.WorkspaceInvitation, workspaceName string) *entities.WorkspaceInvitation {
+	return entities.NewWorkspaceInvitationFromStorage(invitation.ID.String(), invitation.WorkspaceID.String(), workspaceName,
+		invitation.UserID.String(), invitation.InvitedBy.String(), invitation.Role, invitation.CreatedAt)
+}
+
+func (r *mapper) TaskAssignmentToModel(assignment *entities.TaskAssignment) (*models.TaskAssignment, error) {
+	id, err := uuid.Parse(assignment.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := uuid.Parse(assignment.TaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	assignedBy, err := uuid.Parse(assignment.AssignedBy())
+	if err != nil {
+		return nil, err
+	}
+
+	fromUserID, err := optionalUUID(assignment.FromUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	toUserID, err := optionalUUID(assignment.ToUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TaskAssignment{
+		ID:         id,
+		TaskID:     taskID,
+		AssignedBy: assignedBy,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		CreatedAt:  assignment.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) SessionToModel(session *entities.Session) (*models.Session, error) {
+	id, err := uuid.Parse(session.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(session.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := optionalUUID(session.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Session{
+		ID:              id,
+		UserID:          userID,
+		WorkspaceID:     workspaceID,
+		Device:          session.Device(),
+		IP:              session.IP(),
+		CreatedAt:       session.CreatedAt(),
+		LastRefreshedAt: session.LastRefreshedAt(),
+		ExpiresAt:       session.ExpiresAt(),
+	}, nil
+}
+
+func (r *mapper) SessionToDomain(session *models.Session) *entities.Session {
+	var workspaceID string
+	if session.WorkspaceID != nil {
+		workspaceID = session.WorkspaceID.String()
+	}
+
+	return entities.NewSessionFromStorage(session.ID.String(), session.UserID.String(), workspaceID,
+		session.Device, session.IP, session.CreatedAt, session.LastRefreshedAt, session.ExpiresAt)
+}
+
+func (r *mapper) RefreshTokenToModel(token *entities.RefreshToken) (*models.RefreshToken, error) {
+	sessionID, err := uuid.Parse(token.SessionID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RefreshToken{
+		TokenHash: token.TokenHash(),
+		SessionID: sessionID,
+		CreatedAt: token.CreatedAt(),
+		UsedAt:    token.UsedAt(),
+	}, nil
+}
+
+func (r *mapper) RefreshTokenToDomain(token *models.RefreshToken) *entities.RefreshToken {
+	return entities.NewRefreshTokenFromStorage(token.TokenHash, token.SessionID.String(), token.CreatedAt, token.UsedAt)
+}
+
+// optionalUUID parses id, nil if it's empty.
+func optionalUUID(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func (r *mapper) TaskCommandToModel(command *entities.TaskCommand) (*models.TaskCommand, error) {
+	id, err := uuid.Parse(command.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(command.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := uuid.Parse(command.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]models.TaskChange, 0, len(command.Changes()))
+	for _, c := range command.Changes() {
+		var change models.TaskChange
+		if c.Before() != nil {
+			if change.Before, err = r.TaskToModel(c.Before()); err != nil {
+				return nil, err
+			}
+		}
+		if c.After() != nil {
+			if change.After, err = r.TaskToModel(c.After()); err != nil {
+				return nil, err
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TaskCommand{
 		ID:          id,
 		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Operation:   command.Operation(),
+		Changes:     data,
+		Undone:      command.Undone(),
+		CreatedAt:   command.CreatedAt(),
+	}, nil
+}
+
+func (r *mapper) TaskCommandToDomain(command *models.TaskCommand) (*entities.TaskCommand, error) {
+	var changes []models.TaskChange
+	if err := json.Unmarshal(command.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	taskChanges := make([]entities.TaskChange, 0, len(changes))
+	for _, c := range changes {
+		var before, after *entities.Task
+		if c.Before != nil {
+			before = r.TaskToDomain(c.Before)
+		}
+		if c.After != nil {
+			after = r.TaskToDomain(c.After)
+		}
+		taskChanges = append(taskChanges, entities.NewTaskChange(before, after))
+	}
+
+	return entities.NewTaskCommandFromStorage(command.ID.String(), command.UserID.String(), command.WorkspaceID.String(),
+		command.Operation, taskChanges, command.Undone, command.CreatedAt), nil
+}
+
+func (r *mapper) TaskTemplateToModel(template *entities.TaskTemplate) (*models.TaskTemplate, error) {
+	id, err := uuid.Parse(template.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(template.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	subtasks := make([]models.TaskTemplateItem, 0, len(template.Subtasks()))
+	for _, s := range template.Subtasks() {
+		subtasks = append(subtasks, models.TaskTemplateItem{
+			Title:       s.Title(),
+			Description: s.Description(),
+			Priority:    s.Priority(),
+			DueOffset:   s.DueOffset(),
+		})
+	}
+
+	task := template.Task()
+	return &models.TaskTemplate{
+		ID:          id,
+		UserID:      userID,
+		Name:        template.Name(),
 		Title:       task.Title(),
 		Description: task.Description(),
-		Status:      task.Status(),
 		Priority:    task.Priority(),
-		DueDate:     task.DueDate(),
-		CreatedAt:   task.CreatedAt(),
+		DueOffset:   task.DueOffset(),
+		Tags:        strings.Join(template.Tags(), ","),
+		Subtasks:    subtasks,
+		CreatedAt:   template.CreatedAt(),
+		UpdatedAt:   template.UpdatedAt(),
 	}, nil
 }
 
-func (r *mapper) TaskToDomain(task *models.Task) *entities.Task {
-	return entities.NewTaskFromStorage(task.ID.String(), task.UserID.String(),
-		task.Title, task.Description, task.Status, task.Priority, task.DueDate, task.CreatedAt)
+func (r *mapper) TaskTemplateToDomain(template *models.TaskTemplate) *entities.TaskTemplate {
+	subtasks := make([]entities.TaskTemplateItem, 0, len(template.Subtasks))
+	for _, s := range template.Subtasks {
+		subtasks = append(subtasks, entities.NewTaskTemplateItemFromStorage(s.Title, s.Description, s.Priority, s.DueOffset))
+	}
+
+	task := entities.NewTaskTemplateItemFromStorage(template.Title, template.Description, template.Priority, template.DueOffset)
+	return entities.NewTaskTemplateFromStorage(template.ID.String(), template.UserID.String(), template.Name,
+		task, template.Tags, subtasks, template.CreatedAt, template.UpdatedAt)
 }
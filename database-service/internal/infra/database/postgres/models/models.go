@@ -8,16 +8,197 @@ type User struct {
 	ID           uuid.UUID `gorm:"type:uuid;primarykey;not null;index"`
 	Username     string    `gorm:"type:varchar(64);unique;not null"`
 	PasswordHash []byte    `gorm:"not null"`
+	Timezone     string    `gorm:"type:varchar(64);not null;default:''"`
 }
 
 type Task struct {
-	ID          uuid.UUID `gorm:"type:uuid;primarykey;not null;index"`
-	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
-	Title       string    `gorm:"type:varchar(128);not null"`
-	Description string    `gorm:"type:text"`
-	Status      uint8     `gorm:"not null"`
-	Priority    uint8     `gorm:"not null"`
-	DueDate     int64
-	CreatedAt   int64 `gorm:"not null"`
-	User        User  `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+	ID              uuid.UUID  `gorm:"type:uuid;primarykey;not null;index"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index"`
+	WorkspaceID     uuid.UUID  `gorm:"type:uuid;index"` // set by backfillWorkspaces for older tasks
+	AssigneeID      *uuid.UUID `gorm:"type:uuid;index"` // nil if no one is assigned
+	ParentID        *uuid.UUID `gorm:"type:uuid;index"` // nil for top-level tasks
+	Title           string     `gorm:"type:varchar(128);not null"`
+	Description     string     `gorm:"type:text"`
+	Status          uint8      `gorm:"not null"`
+	StatusCategory  uint8      `gorm:"not null;default:0"`
+	Priority        uint8      `gorm:"not null"`
+	DueDate         int64
+	AllDay          bool   `gorm:"not null;default:false"`
+	Project         string `gorm:"type:varchar(64);not null;default:''"` // empty for none
+	EstimateMinutes uint32 `gorm:"not null;default:0"`                   // 0 if not estimated
+	StoryPoints     uint32 `gorm:"not null;default:0"`                   // 0 if not sized
+	Rank            string `gorm:"type:varchar(32);not null;default:''"` // set by backfillTaskRanks for older tasks
+	Tags            string `gorm:"type:text;not null;default:''"`        // comma separated
+	CreatedAt       int64  `gorm:"not null"`
+	UpdatedAt       int64  `gorm:"not null;default:0"`
+	StartedAt       int64  `gorm:"not null;default:0"`
+	CompletedAt     int64  `gorm:"not null;default:0"`
+	User            User   `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+	Assignee        *User  `gorm:"foreignKey:AssigneeID;references:ID;constraint:OnDelete:SET NULL" json:"-"`
+	Parent          *Task  `gorm:"foreignKey:ParentID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// WorkflowStatus is a status of the user's own workflow,
+// users without any statuses use the default workflow.
+type WorkflowStatus struct {
+	UserID   uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	ID       uint8     `gorm:"primarykey;autoIncrement:false;not null"`
+	Name     string    `gorm:"type:varchar(32);not null"`
+	Category uint8     `gorm:"not null"`
+	Position int       `gorm:"not null"`
+	User     User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+type WorkflowTransition struct {
+	UserID uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	FromID uint8     `gorm:"primarykey;autoIncrement:false;not null"`
+	ToID   uint8     `gorm:"primarykey;autoIncrement:false;not null"`
+	User   User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskImport remembers which import row created a task,
+// so importing the same file twice doesn't duplicate tasks.
+type TaskImport struct {
+	UserID  uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	RowHash string    `gorm:"type:char(64);primarykey;not null"`
+	TaskID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	Task    Task      `gorm:"foreignKey:TaskID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+type CalendarFeed struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	TokenHash string    `gorm:"type:char(64);unique;not null"`
+	CreatedAt int64     `gorm:"not null"`
+	User      User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TimeEntry is time spent on a task, StoppedAt is 0 while the timer runs.
+// The partial unique index keeps a single running timer per user.
+type TimeEntry struct {
+	ID        uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_time_entries_running,where:stopped_at = 0"`
+	TaskID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Project   string    `gorm:"type:varchar(64);not null;default:''"`
+	StartedAt int64     `gorm:"not null;index"`
+	StoppedAt int64     `gorm:"not null;default:0"`
+	User      User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+	Task      Task      `gorm:"foreignKey:TaskID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskCollaborator shares a task with another user, the task's user is its owner.
+type TaskCollaborator struct {
+	TaskID    uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	UserID    uuid.UUID `gorm:"type:uuid;primarykey;not null;index"`
+	Role      uint8     `gorm:"not null"`
+	CreatedAt int64     `gorm:"not null"`
+	Task      Task      `gorm:"foreignKey:TaskID;references:ID;constraint:OnDelete:CASCADE"`
+	User      User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// Workspace isolates the tasks of a team, a user's personal workspace has the user's ID.
+type Workspace struct {
+	ID        uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	Name      string    `gorm:"type:varchar(64);not null"`
+	CreatedAt int64     `gorm:"not null"`
+}
+
+type Membership struct {
+	WorkspaceID uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	UserID      uuid.UUID `gorm:"type:uuid;primarykey;not null;index"`
+	Role        uint8     `gorm:"not null"`
+	CreatedAt   int64     `gorm:"not null"`
+	Workspace   Workspace `gorm:"foreignKey:WorkspaceID;references:ID;constraint:OnDelete:CASCADE"`
+	User        User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// WorkspaceInvitation is pending until the user accepts or declines it,
+// a user has at most one invitation to a workspace.
+type WorkspaceInvitation struct {
+	ID          uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_invitations_user"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_invitations_user;index"`
+	InvitedBy   uuid.UUID `gorm:"type:uuid;not null"`
+	Role        uint8     `gorm:"not null"`
+	CreatedAt   int64     `gorm:"not null"`
+	Workspace   Workspace `gorm:"foreignKey:WorkspaceID;references:ID;constraint:OnDelete:CASCADE"`
+	User        User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskAssignment is a reassignment of a task, kept for notifications.
+// Nil user IDs mean the task was unassigned before or after.
+type TaskAssignment struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primarykey;not null"`
+	TaskID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	AssignedBy uuid.UUID  `gorm:"type:uuid;not null"`
+	FromUserID *uuid.UUID `gorm:"type:uuid"`
+	ToUserID   *uuid.UUID `gorm:"type:uuid;index"`
+	CreatedAt  int64      `gorm:"not null;index"`
+	Task       Task       `gorm:"foreignKey:TaskID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskCommand is an entry of a user's undo log in a workspace,
+// Changes is the JSON of its TaskChanges.
+type TaskCommand struct {
+	ID          uuid.UUID `gorm:"type:uuid;primarykey;not null"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index:idx_task_command_log"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index:idx_task_command_log"`
+	Operation   string    `gorm:"type:varchar(32);not null"`
+	Changes     []byte    `gorm:"type:jsonb;not null"`
+	Undone      bool      `gorm:"not null;default:false"`
+	CreatedAt   int64     `gorm:"not null;index:idx_task_command_log"` // in nanoseconds
+	User        User      `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskChange is the state of a task before and after a command, nil where the task doesn't exist.
+type TaskChange struct {
+	Before *Task `json:"before"`
+	After  *Task `json:"after"`
+}
+
+// TaskTemplate is a user's template of a task and its subtasks.
+type TaskTemplate struct {
+	ID          uuid.UUID          `gorm:"type:uuid;primarykey;not null"`
+	UserID      uuid.UUID          `gorm:"type:uuid;not null;index"`
+	Name        string             `gorm:"type:varchar(64);not null"`
+	Title       string             `gorm:"type:varchar(128);not null"`
+	Description string             `gorm:"type:text"`
+	Priority    uint8              `gorm:"not null"`
+	DueOffset   string             `gorm:"type:varchar(8);not null;default:''"`
+	Tags        string             `gorm:"type:text;not null;default:''"` // comma separated
+	Subtasks    []TaskTemplateItem `gorm:"type:jsonb;not null;serializer:json"`
+	CreatedAt   int64              `gorm:"not null"`
+	UpdatedAt   int64              `gorm:"not null"`
+	User        User               `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// TaskTemplateItem is a subtask of a template as stored in its JSON.
+type TaskTemplateItem struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Priority    uint8  `json:"priority"`
+	DueOffset   string `json:"due_offset"`
+}
+
+// Session is a login of a user on a device, WorkspaceID is nil for the personal workspace.
+// Deleting the user revokes its sessions.
+type Session struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primarykey;not null"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index"`
+	WorkspaceID     *uuid.UUID `gorm:"type:uuid"`
+	Device          string     `gorm:"type:varchar(255);not null;default:''"`
+	IP              string     `gorm:"type:varchar(64);not null;default:''"`
+	CreatedAt       int64      `gorm:"not null"`
+	LastRefreshedAt int64      `gorm:"not null"`
+	ExpiresAt       int64      `gorm:"not null;index"`
+	User            User       `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+	Workspace       *Workspace `gorm:"foreignKey:WorkspaceID;references:ID;constraint:OnDelete:SET NULL"`
+}
+
+// RefreshToken is a refresh token of a session by its hash, UsedAt is 0 for the current one.
+type RefreshToken struct {
+	TokenHash string    `gorm:"type:char(64);primarykey;not null"`
+	SessionID uuid.UUID `gorm:"type:uuid;not null;index"`
+	CreatedAt int64     `gorm:"not null"`
+	UsedAt    int64     `gorm:"not null;default:0"`
+	Session   Session   `gorm:"foreignKey:SessionID;references:ID;constraint:OnDelete:CASCADE"`
 }
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"io"
+	"math"
 
 	"github.com/braunkc/todo-app/database-service/internal/application/dto"
 	"github.com/braunkc/todo-app/database-service/internal/application/usecases"
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
 	pb "github.com/braunkc/todo-app/database-service/proto/database"
 	"google.golang.org/grpc"
 )
@@ -17,17 +20,66 @@ type grpcServerService struct {
 type GRPCServerService interface {
 	CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error)
 	GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserByUsernameResponse, error)
+	Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error)
 	DeleteUserByID(ctx context.Context, req *pb.DeleteUserByIDRequest) (*pb.DeleteUserByIDResponse, error)
+	GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.GetUserSettingsResponse, error)
+	UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UpdateUserSettingsResponse, error)
 
 	CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error)
 	GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error)
 	GetTasks(ctx context.Context, req *pb.GetTasksRequest) (*pb.GetTasksResponse, error)
 	UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error)
 	DeleteTasksByID(ctx context.Context, req *pb.DeleteTasksByIDRequest) (*pb.DeleteTasksByIDResponse, error)
+	BulkUpdateTasks(ctx context.Context, req *pb.BulkUpdateTasksRequest) (*pb.BulkUpdateTasksResponse, error)
+	ImportTasks(stream grpc.ClientStreamingServer[pb.ImportTasksRequest, pb.ImportTasksResponse]) error
+	GetTaskStats(ctx context.Context, req *pb.GetTaskStatsRequest) (*pb.GetTaskStatsResponse, error)
+
+	GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (*pb.GetWorkflowResponse, error)
+	UpdateWorkflow(ctx context.Context, req *pb.UpdateWorkflowRequest) (*pb.UpdateWorkflowResponse, error)
+
+	StartTimer(ctx context.Context, req *pb.StartTimerRequest) (*pb.StartTimerResponse, error)
+	StopTimer(ctx context.Context, req *pb.StopTimerRequest) (*pb.StopTimerResponse, error)
+	AddTimeEntry(ctx context.Context, req *pb.AddTimeEntryRequest) (*pb.AddTimeEntryResponse, error)
+	ListTimeEntries(ctx context.Context, req *pb.ListTimeEntriesRequest) (*pb.ListTimeEntriesResponse, error)
+	GetTimeReport(ctx context.Context, req *pb.GetTimeReportRequest) (*pb.GetTimeReportResponse, error)
+
+	ShareTask(ctx context.Context, req *pb.ShareTaskRequest) (*pb.ShareTaskResponse, error)
+	UnshareTask(ctx context.Context, req *pb.UnshareTaskRequest) (*pb.UnshareTaskResponse, error)
+	ListCollaborators(ctx context.Context, req *pb.ListCollaboratorsRequest) (*pb.ListCollaboratorsResponse, error)
+	AssignTask(ctx context.Context, req *pb.AssignTaskRequest) (*pb.AssignTaskResponse, error)
+	MoveTask(ctx context.Context, req *pb.MoveTaskRequest) (*pb.MoveTaskResponse, error)
+	Undo(ctx context.Context, req *pb.UndoRequest) (*pb.UndoResponse, error)
+	Redo(ctx context.Context, req *pb.RedoRequest) (*pb.RedoResponse, error)
+
+	CreateTaskTemplate(ctx context.Context, req *pb.CreateTaskTemplateRequest) (*pb.CreateTaskTemplateResponse, error)
+	ListTaskTemplates(ctx context.Context, req *pb.ListTaskTemplatesRequest) (*pb.ListTaskTemplatesResponse, error)
+	GetTaskTemplate(ctx context.Context, req *pb.GetTaskTemplateRequest) (*pb.GetTaskTemplateResponse, error)
+	UpdateTaskTemplate(ctx context.Context, req *pb.UpdateTaskTemplateRequest) (*pb.UpdateTaskTemplateResponse, error)
+	DeleteTaskTemplate(ctx context.Context, req *pb.DeleteTaskTemplateRequest) (*pb.DeleteTaskTemplateResponse, error)
+	InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error)
+
+	CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error)
+	ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesRequest) (*pb.ListWorkspacesResponse, error)
+	InviteToWorkspace(ctx context.Context, req *pb.InviteToWorkspaceRequest) (*pb.InviteToWorkspaceResponse, error)
+	ListWorkspaceInvitations(ctx context.Context, req *pb.ListWorkspaceInvitationsRequest) (*pb.ListWorkspaceInvitationsResponse, error)
+	RespondToWorkspaceInvitation(ctx context.Context, req *pb.RespondToWorkspaceInvitationRequest) (*pb.RespondToWorkspaceInvitationResponse, error)
+	LeaveWorkspace(ctx context.Context, req *pb.LeaveWorkspaceRequest) (*pb.LeaveWorkspaceResponse, error)
+
+	CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CreateCalendarFeedResponse, error)
+	RevokeCalendarFeed(ctx context.Context, req *pb.RevokeCalendarFeedRequest) (*pb.RevokeCalendarFeedResponse, error)
+	ResolveCalendarFeed(ctx context.Context, req *pb.ResolveCalendarFeedRequest) (*pb.ResolveCalendarFeedResponse, error)
+
+	CreateSession(ctx context.Context, req *pb.CreateSessionRequest) (*pb.CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.RefreshSessionResponse, error)
+	GetSession(ctx context.Context, req *pb.GetSessionRequest) (*pb.GetSessionResponse, error)
+	ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error)
+	SwitchSessionWorkspace(ctx context.Context, req *pb.SwitchSessionWorkspaceRequest) (*pb.SwitchSessionWorkspaceResponse, error)
 }
 
-func New(usecasesService usecases.UsecasesService) *grpc.Server {
-	grpcServer := grpc.NewServer()
+func New(usecasesService usecases.UsecasesService, opts ...grpc.ServerOption) *grpc.Server {
+	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterDataBaseServiceServer(grpcServer, &grpcServerService{
 		usecasesService: usecasesService,
 	})
@@ -48,9 +100,8 @@ func (g *grpcServerService) CreateUser(ctx context.Context, req *pb.CreateUserRe
 
 	return &pb.CreateUserResponse{
 		User: &pb.User{
-			Id:           resp.User.ID,
-			Username:     resp.User.Username,
-			PasswordHash: resp.User.PasswordHash,
+			Id:       resp.User.ID,
+			Username: resp.User.Username,
 		},
 	}, nil
 }
@@ -67,9 +118,27 @@ func (g *grpcServerService) GetUserByUsername(ctx context.Context, req *pb.GetUs
 
 	return &pb.GetUserByUsernameResponse{
 		User: &pb.User{
-			Id:           resp.User.ID,
-			Username:     resp.User.Username,
-			PasswordHash: resp.User.PasswordHash,
+			Id:       resp.User.ID,
+			Username: resp.User.Username,
+		},
+	}, nil
+}
+
+func (g *grpcServerService) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+	r := dto.AuthenticateRequest{
+		Username: req.Username,
+		Password: req.Password,
+	}
+
+	resp, err := g.usecasesService.Authenticate(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuthenticateResponse{
+		User: &pb.User{
+			Id:       resp.User.ID,
+			Username: resp.User.Username,
 		},
 	}, nil
 }
@@ -87,12 +156,41 @@ func (g *grpcServerService) DeleteUserByID(ctx context.Context, req *pb.DeleteUs
 	return &pb.DeleteUserByIDResponse{}, nil
 }
 
+func (g *grpcServerService) GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.GetUserSettingsResponse, error) {
+	resp, err := g.usecasesService.GetUserSettings(ctx, &dto.GetUserSettingsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserSettingsResponse{
+		Timezone: resp.Timezone,
+	}, nil
+}
+
+func (g *grpcServerService) UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UpdateUserSettingsResponse, error) {
+	resp, err := g.usecasesService.UpdateUserSettings(ctx, &dto.UpdateUserSettingsRequest{
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserSettingsResponse{
+		Timezone: resp.Timezone,
+	}, nil
+}
+
 func (g *grpcServerService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	r := dto.CreateTaskRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    dto.TaskPriority(req.Priority),
-		DueDate:     req.DueDate,
+		Title:           req.Title,
+		Description:     req.Description,
+		Priority:        dto.TaskPriority(req.Priority),
+		DueDate:         req.DueDate,
+		Project:         req.Project,
+		AllDay:          req.AllDay,
+		EstimateMinutes: req.EstimateMinutes,
+		StoryPoints:     req.StoryPoints,
+		Tags:            req.Tags,
 	}
 
 	resp, err := g.usecasesService.CreateTask(ctx, &r)
@@ -121,20 +219,6 @@ func (g *grpcServerService) GetTask(ctx context.Context, req *pb.GetTaskRequest)
 }
 
 func (g *grpcServerService) GetTasks(ctx context.Context, req *pb.GetTasksRequest) (*pb.GetTasksResponse, error) {
-	taskStatuses := make([]dto.TaskStatus, 0)
-	taskPriorities := make([]dto.TaskPriority, 0)
-	if req.Filters != nil {
-		taskStatuses = make([]dto.TaskStatus, 0, len(req.Filters.TaskStatuses))
-		for _, status := range req.Filters.TaskStatuses {
-			taskStatuses = append(taskStatuses, dto.TaskStatus(status))
-		}
-
-		taskPriorities = make([]dto.TaskPriority, 0, len(req.Filters.TaskPriorities))
-		for _, priority := range req.Filters.TaskPriorities {
-			taskPriorities = append(taskPriorities, dto.TaskPriority(priority))
-		}
-	}
-
 	if req.Title == nil {
 		title := ""
 		req.Title = &title
@@ -148,15 +232,13 @@ func (g *grpcServerService) GetTasks(ctx context.Context, req *pb.GetTasksReques
 	r := dto.GetTasksRequest{
 		PageSize:   req.PageSize,
 		PageNumber: req.PageNumber,
-		Filters: dto.Filters{
-			TaskStatuses:   taskStatuses,
-			TaskPriorities: taskPriorities,
-		},
-		OrderBy: dto.OrderBy{
-			Field:     dto.SortField(req.OrderBy.Field),
-			Direction: dto.SortDirection(req.OrderBy.Direction),
-		},
-		Title: *req.Title,
+		Filters:    mapFiltersToDTO(req.Filters),
+		OrderBy:    mapOrderByToDTO(req.OrderBy),
+		Title:      *req.Title,
+	}
+
+	for _, key := range req.SortKeys {
+		r.SortKeys = append(r.SortKeys, mapOrderByToDTO(key))
 	}
 
 	resp, err := g.usecasesService.GetTasks(ctx, &r)
@@ -167,13 +249,22 @@ func (g *grpcServerService) GetTasks(ctx context.Context, req *pb.GetTasksReques
 	var tasks []*pb.Task
 	for _, task := range resp.Tasks {
 		tasks = append(tasks, &pb.Task{
-			Id:          task.ID,
-			Title:       task.Title,
-			Description: task.Description,
-			Status:      pb.TaskStatus(task.Status),
-			Priority:    pb.TaskPriority(task.Priority),
-			DueDate:     task.DueDate,
-			CreatedAt:   task.CreatedAt,
+			Id:              task.ID,
+			Title:           task.Title,
+			Description:     task.Description,
+			Status:          pb.TaskStatus(task.Status),
+			StatusCategory:  pb.StatusCategory(task.StatusCategory),
+			Priority:        pb.TaskPriority(task.Priority),
+			DueDate:         task.DueDate,
+			AllDay:          task.AllDay,
+			Overdue:         task.Overdue,
+			TrackedSeconds:  task.TrackedSeconds,
+			EstimateMinutes: task.EstimateMinutes,
+			StoryPoints:     task.StoryPoints,
+			CreatedAt:       task.CreatedAt,
+			UpdatedAt:       task.UpdatedAt,
+			StartedAt:       task.StartedAt,
+			CompletedAt:     task.CompletedAt,
 		})
 	}
 
@@ -189,16 +280,10 @@ func ptr[T any](v T) *T {
 }
 
 func (g *grpcServerService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	// statuses of custom workflows are outside the enum, the workflow validates them
 	var status *dto.TaskStatus
 	if req.Status != nil {
-		switch *req.Status {
-		case *pb.TaskStatus_TODO.Enum():
-			status = ptr(dto.TaskStatusTodo)
-		case *pb.TaskStatus_IN_PROGRESS.Enum():
-			status = ptr(dto.TaskStatusInProgress)
-		case *pb.TaskStatus_DONE.Enum():
-			status = ptr(dto.TaskStatusDone)
-		}
+		status = ptr(dto.TaskStatus(*req.Status))
 	}
 
 	var priority *dto.TaskPriority
@@ -214,12 +299,19 @@ func (g *grpcServerService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRe
 	}
 
 	r := dto.UpdateTaskRequest{
-		ID:          req.Id,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      status,
-		Priority:    priority,
-		DueDate:     req.DueDate,
+		ID:              req.Id,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          status,
+		Priority:        priority,
+		DueDate:         req.DueDate,
+		Project:         req.Project,
+		AllDay:          req.AllDay,
+		EstimateMinutes: req.EstimateMinutes,
+		StoryPoints:     req.StoryPoints,
+	}
+	if req.Tags != nil {
+		r.Tags = &req.Tags.Tags
 	}
 
 	resp, err := g.usecasesService.UpdateTask(ctx, &r)
@@ -245,15 +337,929 @@ func (g *grpcServerService) DeleteTasksByID(ctx context.Context, req *pb.DeleteT
 	return &pb.DeleteTasksByIDResponse{}, nil
 }
 
+func (g *grpcServerService) BulkUpdateTasks(ctx context.Context, req *pb.BulkUpdateTasksRequest) (*pb.BulkUpdateTasksResponse, error) {
+	var filters *dto.Filters
+	if req.Filters != nil {
+		filters = ptr(mapFiltersToDTO(req.Filters))
+	}
+
+	var patch dto.TaskPatch
+	if req.Patch != nil {
+		if req.Patch.Status != nil {
+			patch.Status = ptr(dto.TaskStatus(*req.Patch.Status))
+		}
+		if req.Patch.Priority != nil {
+			patch.Priority = ptr(dto.TaskPriority(*req.Patch.Priority))
+		}
+		patch.DueDate = req.Patch.DueDate
+		patch.Project = req.Patch.Project
+	}
+
+	r := dto.BulkUpdateTasksRequest{
+		IDs:     req.Ids,
+		Filters: filters,
+		Patch:   patch,
+	}
+
+	resp, err := g.usecasesService.BulkUpdateTasks(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.BulkUpdateTaskResult, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		results = append(results, &pb.BulkUpdateTaskResult{
+			Id:      result.ID,
+			Updated: result.Updated,
+			Error:   result.Error,
+		})
+	}
+
+	return &pb.BulkUpdateTasksResponse{
+		Results:      results,
+		UpdatedCount: resp.UpdatedCount,
+	}, nil
+}
+
+// ImportTasks imports every received message as a separate batch,
+// so a large file never has to be held in memory at once.
+func (g *grpcServerService) ImportTasks(stream grpc.ClientStreamingServer[pb.ImportTasksRequest, pb.ImportTasksResponse]) error {
+	var resp pb.ImportTasksResponse
+	var dryRun bool
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			dryRun = req.DryRun
+		}
+
+		rows := make([]dto.ImportTaskRow, 0, len(req.Rows))
+		for _, row := range req.Rows {
+			rows = append(rows, dto.ImportTaskRow{
+				Line:        row.Line,
+				Title:       row.Title,
+				Description: row.Description,
+				Status:      row.Status,
+				Priority:    row.Priority,
+				DueDate:     row.DueDate,
+				Project:     row.Project,
+				Tags:        row.Tags,
+			})
+		}
+
+		batch, err := g.usecasesService.ImportTasks(stream.Context(), &dto.ImportTasksRequest{
+			DryRun: dryRun,
+			Rows:   rows,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, result := range batch.Results {
+			resp.Results = append(resp.Results, &pb.ImportRowResult{
+				Line:      result.Line,
+				TaskId:    result.TaskID,
+				Duplicate: result.Duplicate,
+				Errors:    result.Errors,
+			})
+		}
+		resp.CreatedCount += batch.CreatedCount
+		resp.DuplicateCount += batch.DuplicateCount
+		resp.FailedCount += batch.FailedCount
+	}
+}
+
+func (g *grpcServerService) GetTaskStats(ctx context.Context, req *pb.GetTaskStatsRequest) (*pb.GetTaskStatsResponse, error) {
+	resp, err := g.usecasesService.GetTaskStats(ctx, &dto.GetTaskStatsRequest{
+		From:     req.From,
+		To:       req.To,
+		Bucket:   dto.StatsBucket(req.Bucket),
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &pb.GetTaskStatsResponse{
+		OverdueCount:         resp.OverdueCount,
+		CompletedCount:       resp.CompletedCount,
+		AvgCompletionSeconds: resp.AvgCompletionSeconds,
+		AvgCycleTimeSeconds:  resp.AvgCycleTimeSeconds,
+		OpenEstimateMinutes:  resp.OpenEstimateMinutes,
+		OpenStoryPoints:      resp.OpenStoryPoints,
+		CompletedStoryPoints: resp.CompletedStoryPoints,
+	}
+
+	for _, c := range resp.OpenByStatus {
+		stats.OpenByStatus = append(stats.OpenByStatus, &pb.StatusCount{
+			Status: pb.TaskStatus(c.Status),
+			Count:  c.Count,
+		})
+	}
+
+	for _, c := range resp.OpenByPriority {
+		stats.OpenByPriority = append(stats.OpenByPriority, &pb.PriorityCount{
+			Priority: pb.TaskPriority(c.Priority),
+			Count:    c.Count,
+		})
+	}
+
+	for _, b := range resp.Completed {
+		stats.Completed = append(stats.Completed, &pb.CompletedBucket{
+			Start: b.Start,
+			Count: b.Count,
+		})
+	}
+
+	return stats, nil
+}
+
+func (g *grpcServerService) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (*pb.GetWorkflowResponse, error) {
+	resp, err := g.usecasesService.GetWorkflow(ctx, &dto.GetWorkflowRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWorkflowResponse{
+		Workflow: mapWorkflowToPB(resp.Workflow),
+	}, nil
+}
+
+func (g *grpcServerService) UpdateWorkflow(ctx context.Context, req *pb.UpdateWorkflowRequest) (*pb.UpdateWorkflowResponse, error) {
+	var r dto.UpdateWorkflowRequest
+	for _, status := range req.Statuses {
+		if status.Id > math.MaxUint8 {
+			return nil, errors.ErrInvalidField
+		}
+
+		r.Statuses = append(r.Statuses, dto.WorkflowStatus{
+			ID:       dto.TaskStatus(status.Id),
+			Name:     status.Name,
+			Category: dto.StatusCategory(status.Category),
+		})
+	}
+
+	for _, transition := range req.Transitions {
+		if transition.From > math.MaxUint8 || transition.To > math.MaxUint8 {
+			return nil, errors.ErrInvalidField
+		}
+
+		r.Transitions = append(r.Transitions, dto.WorkflowTransition{
+			From: dto.TaskStatus(transition.From),
+			To:   dto.TaskStatus(transition.To),
+		})
+	}
+
+	resp, err := g.usecasesService.UpdateWorkflow(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateWorkflowResponse{
+		Workflow: mapWorkflowToPB(resp.Workflow),
+	}, nil
+}
+
+func (g *grpcServerService) CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CreateCalendarFeedResponse, error) {
+	resp, err := g.usecasesService.CreateCalendarFeed(ctx, &dto.CreateCalendarFeedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCalendarFeedResponse{
+		Token: resp.Token,
+	}, nil
+}
+
+func (g *grpcServerService) RevokeCalendarFeed(ctx context.Context, req *pb.RevokeCalendarFeedRequest) (*pb.RevokeCalendarFeedResponse, error) {
+	_, err := g.usecasesService.RevokeCalendarFeed(ctx, &dto.RevokeCalendarFeedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeCalendarFeedResponse{}, nil
+}
+
+func (g *grpcServerService) ResolveCalendarFeed(ctx context.Context, req *pb.ResolveCalendarFeedRequest) (*pb.ResolveCalendarFeedResponse, error) {
+	r := dto.ResolveCalendarFeedRequest{
+		Token: req.Token,
+	}
+
+	resp, err := g.usecasesService.ResolveCalendarFeed(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResolveCalendarFeedResponse{
+		UserId: resp.UserID,
+	}, nil
+}
+
+func (g *grpcServerService) CreateSession(ctx context.Context, req *pb.CreateSessionRequest) (*pb.CreateSessionResponse, error) {
+	r := dto.CreateSessionRequest{
+		Device: req.Device,
+		IP:     req.Ip,
+	}
+
+	resp, err := g.usecasesService.CreateSession(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSessionResponse{
+		Session:      mapSessionToPB(resp.Session),
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (g *grpcServerService) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.RefreshSessionResponse, error) {
+	r := dto.RefreshSessionRequest{
+		RefreshToken: req.RefreshToken,
+		Device:       req.Device,
+		IP:           req.Ip,
+	}
+
+	resp, err := g.usecasesService.RefreshSession(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefreshSessionResponse{
+		Session:      mapSessionToPB(resp.Session),
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (g *grpcServerService) GetSession(ctx context.Context, req *pb.GetSessionRequest) (*pb.GetSessionResponse, error) {
+	resp, err := g.usecasesService.GetSession(ctx, &dto.GetSessionRequest{
+		ID: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSessionResponse{
+		Session: mapSessionToPB(resp.Session),
+	}, nil
+}
+
+func (g *grpcServerService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	resp, err := g.usecasesService.ListSessions(ctx, &dto.ListSessionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*pb.Session, 0, len(resp.Sessions))
+	for _, s := range resp.Sessions {
+		sessions = append(sessions, mapSessionToPB(s))
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: sessions,
+	}, nil
+}
+
+func (g *grpcServerService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	_, err := g.usecasesService.RevokeSession(ctx, &dto.RevokeSessionRequest{
+		ID: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func (g *grpcServerService) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	_, err := g.usecasesService.RevokeAllSessions(ctx, &dto.RevokeAllSessionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeAllSessionsResponse{}, nil
+}
+
+func (g *grpcServerService) SwitchSessionWorkspace(ctx context.Context, req *pb.SwitchSessionWorkspaceRequest) (*pb.SwitchSessionWorkspaceResponse, error) {
+	r := dto.SwitchSessionWorkspaceRequest{
+		ID:          req.Id,
+		WorkspaceID: req.WorkspaceId,
+	}
+
+	resp, err := g.usecasesService.SwitchSessionWorkspace(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SwitchSessionWorkspaceResponse{
+		Session: mapSessionToPB(resp.Session),
+	}, nil
+}
+
+func (g *grpcServerService) StartTimer(ctx context.Context, req *pb.StartTimerRequest) (*pb.StartTimerResponse, error) {
+	r := dto.StartTimerRequest{
+		TaskID:  req.TaskId,
+		Project: req.Project,
+	}
+
+	resp, err := g.usecasesService.StartTimer(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	started := &pb.StartTimerResponse{
+		Entry: mapTimeEntryToPB(resp.Entry),
+	}
+	if resp.Stopped != nil {
+		started.Stopped = mapTimeEntryToPB(*resp.Stopped)
+	}
+
+	return started, nil
+}
+
+func (g *grpcServerService) StopTimer(ctx context.Context, req *pb.StopTimerRequest) (*pb.StopTimerResponse, error) {
+	resp, err := g.usecasesService.StopTimer(ctx, &dto.StopTimerRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StopTimerResponse{
+		Entry: mapTimeEntryToPB(resp.Entry),
+	}, nil
+}
+
+func (g *grpcServerService) AddTimeEntry(ctx context.Context, req *pb.AddTimeEntryRequest) (*pb.AddTimeEntryResponse, error) {
+	r := dto.AddTimeEntryRequest{
+		TaskID:    req.TaskId,
+		Project:   req.Project,
+		StartedAt: req.StartedAt,
+		StoppedAt: req.StoppedAt,
+	}
+
+	resp, err := g.usecasesService.AddTimeEntry(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddTimeEntryResponse{
+		Entry: mapTimeEntryToPB(resp.Entry),
+	}, nil
+}
+
+func (g *grpcServerService) ListTimeEntries(ctx context.Context, req *pb.ListTimeEntriesRequest) (*pb.ListTimeEntriesResponse, error) {
+	r := dto.ListTimeEntriesRequest{
+		TaskID:   req.TaskId,
+		From:     req.From,
+		To:       req.To,
+		Timezone: req.Timezone,
+	}
+
+	resp, err := g.usecasesService.ListTimeEntries(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.TimeEntry, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		entries = append(entries, mapTimeEntryToPB(entry))
+	}
+
+	return &pb.ListTimeEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (g *grpcServerService) GetTimeReport(ctx context.Context, req *pb.GetTimeReportRequest) (*pb.GetTimeReportResponse, error) {
+	r := dto.GetTimeReportRequest{
+		From:     req.From,
+		To:       req.To,
+		Timezone: req.Timezone,
+	}
+
+	resp, err := g.usecasesService.GetTimeReport(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &pb.GetTimeReportResponse{
+		Rows:         make([]*pb.TimeReportRow, 0, len(resp.Rows)),
+		TotalSeconds: resp.TotalSeconds,
+	}
+	for _, row := range resp.Rows {
+		report.Rows = append(report.Rows, &pb.TimeReportRow{
+			Day:       row.Day,
+			TaskId:    row.TaskID,
+			TaskTitle: row.TaskTitle,
+			Project:   row.Project,
+			Seconds:   row.Seconds,
+		})
+	}
+
+	return report, nil
+}
+
+func (g *grpcServerService) ShareTask(ctx context.Context, req *pb.ShareTaskRequest) (*pb.ShareTaskResponse, error) {
+	r := dto.ShareTaskRequest{
+		TaskID:   req.TaskId,
+		Username: req.Username,
+		Role:     dto.TaskRole(req.Role),
+	}
+
+	resp, err := g.usecasesService.ShareTask(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ShareTaskResponse{
+		Collaborator: mapCollaboratorToPB(resp.Collaborator),
+	}, nil
+}
+
+func (g *grpcServerService) UnshareTask(ctx context.Context, req *pb.UnshareTaskRequest) (*pb.UnshareTaskResponse, error) {
+	r := dto.UnshareTaskRequest{
+		TaskID:   req.TaskId,
+		Username: req.Username,
+	}
+
+	if _, err := g.usecasesService.UnshareTask(ctx, &r); err != nil {
+		return nil, err
+	}
+
+	return &pb.UnshareTaskResponse{}, nil
+}
+
+func (g *grpcServerService) ListCollaborators(ctx context.Context, req *pb.ListCollaboratorsRequest) (*pb.ListCollaboratorsResponse, error) {
+	r := dto.ListCollaboratorsRequest{
+		TaskID: req.TaskId,
+	}
+
+	resp, err := g.usecasesService.ListCollaborators(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]*pb.Collaborator, 0, len(resp.Collaborators))
+	for _, c := range resp.Collaborators {
+		collaborators = append(collaborators, mapCollaboratorToPB(c))
+	}
+
+	return &pb.ListCollaboratorsResponse{
+		Collaborators: collaborators,
+	}, nil
+}
+
+func (g *grpcServerService) AssignTask(ctx context.Context, req *pb.AssignTaskRequest) (*pb.AssignTaskResponse, error) {
+	r := dto.AssignTaskRequest{
+		TaskID:   req.TaskId,
+		Username: req.Username,
+	}
+
+	resp, err := g.usecasesService.AssignTask(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AssignTaskResponse{
+		Task: mapTaskToPB(resp.Task),
+	}, nil
+}
+
+func (g *grpcServerService) MoveTask(ctx context.Context, req *pb.MoveTaskRequest) (*pb.MoveTaskResponse, error) {
+	r := dto.MoveTaskRequest{
+		ID:       req.Id,
+		BeforeID: req.BeforeId,
+		AfterID:  req.AfterId,
+	}
+
+	if req.Status != nil {
+		r.Status = ptr(dto.TaskStatus(*req.Status))
+	}
+
+	resp, err := g.usecasesService.MoveTask(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveTaskResponse{
+		Task: mapTaskToPB(resp.Task),
+	}, nil
+}
+
+func (g *grpcServerService) Undo(ctx context.Context, req *pb.UndoRequest) (*pb.UndoResponse, error) {
+	resp, err := g.usecasesService.Undo(ctx, &dto.UndoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*pb.Task, 0, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		tasks = append(tasks, mapTaskToPB(t))
+	}
+
+	return &pb.UndoResponse{
+		Operation:      resp.Operation,
+		Tasks:          tasks,
+		DeletedTaskIds: resp.DeletedTaskIDs,
+	}, nil
+}
+
+func (g *grpcServerService) Redo(ctx context.Context, req *pb.RedoRequest) (*pb.RedoResponse, error) {
+	resp, err := g.usecasesService.Redo(ctx, &dto.RedoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*pb.Task, 0, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		tasks = append(tasks, mapTaskToPB(t))
+	}
+
+	return &pb.RedoResponse{
+		Operation:      resp.Operation,
+		Tasks:          tasks,
+		DeletedTaskIds: resp.DeletedTaskIDs,
+	}, nil
+}
+
+func (g *grpcServerService) CreateTaskTemplate(ctx context.Context, req *pb.CreateTaskTemplateRequest) (*pb.CreateTaskTemplateResponse, error) {
+	r := dto.CreateTaskTemplateRequest{
+		Name:     req.Name,
+		Task:     mapTemplateItemToDTO(req.Task),
+		Tags:     req.Tags,
+		Subtasks: mapTemplateItemsToDTO(req.Subtasks),
+	}
+
+	resp, err := g.usecasesService.CreateTaskTemplate(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTaskTemplateResponse{
+		Template: mapTemplateToPB(resp.Template),
+	}, nil
+}
+
+func (g *grpcServerService) ListTaskTemplates(ctx context.Context, req *pb.ListTaskTemplatesRequest) (*pb.ListTaskTemplatesResponse, error) {
+	resp, err := g.usecasesService.ListTaskTemplates(ctx, &dto.ListTaskTemplatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*pb.TaskTemplate, 0, len(resp.Templates))
+	for _, t := range resp.Templates {
+		templates = append(templates, mapTemplateToPB(t))
+	}
+
+	return &pb.ListTaskTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func (g *grpcServerService) GetTaskTemplate(ctx context.Context, req *pb.GetTaskTemplateRequest) (*pb.GetTaskTemplateResponse, error) {
+	resp, err := g.usecasesService.GetTaskTemplate(ctx, &dto.GetTaskTemplateRequest{ID: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTaskTemplateResponse{
+		Template: mapTemplateToPB(resp.Template),
+	}, nil
+}
+
+func (g *grpcServerService) UpdateTaskTemplate(ctx context.Context, req *pb.UpdateTaskTemplateRequest) (*pb.UpdateTaskTemplateResponse, error) {
+	r := dto.UpdateTaskTemplateRequest{
+		ID:       req.Id,
+		Name:     req.Name,
+		Task:     mapTemplateItemToDTO(req.Task),
+		Tags:     req.Tags,
+		Subtasks: mapTemplateItemsToDTO(req.Subtasks),
+	}
+
+	resp, err := g.usecasesService.UpdateTaskTemplate(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTaskTemplateResponse{
+		Template: mapTemplateToPB(resp.Template),
+	}, nil
+}
+
+func (g *grpcServerService) DeleteTaskTemplate(ctx context.Context, req *pb.DeleteTaskTemplateRequest) (*pb.DeleteTaskTemplateResponse, error) {
+	if _, err := g.usecasesService.DeleteTaskTemplate(ctx, &dto.DeleteTaskTemplateRequest{ID: req.Id}); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTaskTemplateResponse{}, nil
+}
+
+func (g *grpcServerService) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	r := dto.InstantiateTemplateRequest{
+		TemplateID: req.TemplateId,
+		Variables:  req.Variables,
+	}
+
+	resp, err := g.usecasesService.InstantiateTemplate(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*pb.Task, 0, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		tasks = append(tasks, mapTaskToPB(t))
+	}
+
+	return &pb.InstantiateTemplateResponse{
+		Tasks: tasks,
+	}, nil
+}
+
+func (g *grpcServerService) CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error) {
+	r := dto.CreateWorkspaceRequest{
+		Name: req.Name,
+	}
+
+	resp, err := g.usecasesService.CreateWorkspace(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWorkspaceResponse{
+		Workspace: mapWorkspaceToPB(resp.Workspace),
+	}, nil
+}
+
+func (g *grpcServerService) ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesRequest) (*pb.ListWorkspacesResponse, error) {
+	resp, err := g.usecasesService.ListWorkspaces(ctx, &dto.ListWorkspacesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*pb.Workspace, 0, len(resp.Workspaces))
+	for _, w := range resp.Workspaces {
+		workspaces = append(workspaces, mapWorkspaceToPB(w))
+	}
+
+	return &pb.ListWorkspacesResponse{
+		Workspaces: workspaces,
+	}, nil
+}
+
+func (g *grpcServerService) InviteToWorkspace(ctx context.Context, req *pb.InviteToWorkspaceRequest) (*pb.InviteToWorkspaceResponse, error) {
+	r := dto.InviteToWorkspaceRequest{
+		WorkspaceID: req.WorkspaceId,
+		Username:    req.Username,
+		Role:        dto.WorkspaceRole(req.Role),
+	}
+
+	resp, err := g.usecasesService.InviteToWorkspace(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InviteToWorkspaceResponse{
+		Invitation: mapInvitationToPB(resp.Invitation),
+	}, nil
+}
+
+func (g *grpcServerService) ListWorkspaceInvitations(ctx context.Context, req *pb.ListWorkspaceInvitationsRequest) (*pb.ListWorkspaceInvitationsResponse, error) {
+	resp, err := g.usecasesService.ListWorkspaceInvitations(ctx, &dto.ListWorkspaceInvitationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*pb.WorkspaceInvitation, 0, len(resp.Invitations))
+	for _, i := range resp.Invitations {
+		invitations = append(invitations, mapInvitationToPB(i))
+	}
+
+	return &pb.ListWorkspaceInvitationsResponse{
+		Invitations: invitations,
+	}, nil
+}
+
+func (g *grpcServerService) RespondToWorkspaceInvitation(ctx context.Context, req *pb.RespondToWorkspaceInvitationRequest) (*pb.RespondToWorkspaceInvitationResponse, error) {
+	r := dto.RespondToWorkspaceInvitationRequest{
+		InvitationID: req.InvitationId,
+		Accept:       req.Accept,
+	}
+
+	resp, err := g.usecasesService.RespondToWorkspaceInvitation(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspace *pb.Workspace
+	if resp.Workspace != nil {
+		workspace = mapWorkspaceToPB(*resp.Workspace)
+	}
+
+	return &pb.RespondToWorkspaceInvitationResponse{
+		Workspace: workspace,
+	}, nil
+}
+
+func (g *grpcServerService) LeaveWorkspace(ctx context.Context, req *pb.LeaveWorkspaceRequest) (*pb.LeaveWorkspaceResponse, error) {
+	r := dto.LeaveWorkspaceRequest{
+		WorkspaceID: req.WorkspaceId,
+	}
+
+	if _, err := g.usecasesService.LeaveWorkspace(ctx, &r); err != nil {
+		return nil, err
+	}
+
+	return &pb.LeaveWorkspaceResponse{}, nil
+}
+
+func mapWorkspaceToPB(w dto.Workspace) *pb.Workspace {
+	return &pb.Workspace{
+		Id:       w.ID,
+		Name:     w.Name,
+		Role:     pb.WorkspaceRole(w.Role),
+		Personal: w.Personal,
+	}
+}
+
+func mapInvitationToPB(i dto.WorkspaceInvitation) *pb.WorkspaceInvitation {
+	return &pb.WorkspaceInvitation{
+		Id:            i.ID,
+		WorkspaceId:   i.WorkspaceID,
+		WorkspaceName: i.WorkspaceName,
+		InvitedBy:     i.InvitedBy,
+		Role:          pb.WorkspaceRole(i.Role),
+		CreatedAt:     i.CreatedAt,
+	}
+}
+
+func mapCollaboratorToPB(c dto.Collaborator) *pb.Collaborator {
+	return &pb.Collaborator{
+		UserId:   c.UserID,
+		Username: c.Username,
+		Role:     pb.TaskRole(c.Role),
+	}
+}
+
+func mapFiltersToDTO(f *pb.Filters) dto.Filters {
+	filters := dto.Filters{
+		TaskStatuses:   make([]dto.TaskStatus, 0),
+		TaskPriorities: make([]dto.TaskPriority, 0),
+	}
+	if f == nil {
+		return filters
+	}
+
+	filters.DueBefore = f.DueBefore
+	filters.DueAfter = f.DueAfter
+	filters.CreatedBefore = f.CreatedBefore
+	filters.CreatedAfter = f.CreatedAfter
+	filters.OverdueOnly = f.OverdueOnly
+	filters.HasDueDate = f.HasDueDate
+	filters.HasEstimate = f.HasEstimate
+	filters.MaxEstimate = f.MaxEstimateMinutes
+	filters.StoryPoints = f.StoryPoints
+	filters.SharedWithMe = f.SharedWithMe
+	filters.AssignedToMe = f.AssignedToMe
+	filters.AssigneeID = f.AssigneeId
+	filters.Timezone = f.Timezone
+
+	for _, status := range f.TaskStatuses {
+		filters.TaskStatuses = append(filters.TaskStatuses, dto.TaskStatus(status))
+	}
+
+	for _, priority := range f.TaskPriorities {
+		filters.TaskPriorities = append(filters.TaskPriorities, dto.TaskPriority(priority))
+	}
+
+	return filters
+}
+
+func mapOrderByToDTO(o *pb.OrderBy) dto.OrderBy {
+	return dto.OrderBy{
+		Field:     dto.SortField(o.Field),
+		Direction: dto.SortDirection(o.Direction),
+		Nulls:     dto.NullsOrder(o.Nulls),
+	}
+}
+
 func mapTaskToPB(t dto.Task) *pb.Task {
 	return &pb.Task{
-		Id:          t.ID,
-		UserId:      t.UserID,
-		Title:       t.Title,
-		Description: t.Description,
-		Status:      pb.TaskStatus(t.Status),
-		Priority:    pb.TaskPriority(t.Priority),
-		DueDate:     t.DueDate,
-		CreatedAt:   t.CreatedAt,
+		Id:              t.ID,
+		UserId:          t.UserID,
+		AssigneeId:      t.AssigneeID,
+		ParentId:        t.ParentID,
+		Rank:            t.Rank,
+		Title:           t.Title,
+		Description:     t.Description,
+		Status:          pb.TaskStatus(t.Status),
+		StatusCategory:  pb.StatusCategory(t.StatusCategory),
+		Priority:        pb.TaskPriority(t.Priority),
+		DueDate:         t.DueDate,
+		Project:         t.Project,
+		AllDay:          t.AllDay,
+		Overdue:         t.Overdue,
+		TrackedSeconds:  t.TrackedSeconds,
+		EstimateMinutes: t.EstimateMinutes,
+		StoryPoints:     t.StoryPoints,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+		StartedAt:       t.StartedAt,
+		CompletedAt:     t.CompletedAt,
+		Tags:            t.Tags,
+	}
+}
+
+func mapTemplateItemToDTO(i *pb.TaskTemplateItem) dto.TaskTemplateItem {
+	return dto.TaskTemplateItem{
+		Title:       i.GetTitle(),
+		Description: i.GetDescription(),
+		Priority:    dto.TaskPriority(i.GetPriority()),
+		DueOffset:   i.GetDueOffset(),
+	}
+}
+
+func mapTemplateItemsToDTO(items []*pb.TaskTemplateItem) []dto.TaskTemplateItem {
+	subtasks := make([]dto.TaskTemplateItem, 0, len(items))
+	for _, i := range items {
+		subtasks = append(subtasks, mapTemplateItemToDTO(i))
+	}
+
+	return subtasks
+}
+
+func mapTemplateItemToPB(i dto.TaskTemplateItem) *pb.TaskTemplateItem {
+	return &pb.TaskTemplateItem{
+		Title:       i.Title,
+		Description: i.Description,
+		Priority:    pb.TaskPriority(i.Priority),
+		DueOffset:   i.DueOffset,
+	}
+}
+
+func mapTemplateToPB(t dto.TaskTemplate) *pb.TaskTemplate {
+	subtasks := make([]*pb.TaskTemplateItem, 0, len(t.Subtasks))
+	for _, s := range t.Subtasks {
+		subtasks = append(subtasks, mapTemplateItemToPB(s))
+	}
+
+	return &pb.TaskTemplate{
+		Id:        t.ID,
+		Name:      t.Name,
+		Task:      mapTemplateItemToPB(t.Task),
+		Tags:      t.Tags,
+		Subtasks:  subtasks,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func mapSessionToPB(s dto.Session) *pb.Session {
+	return &pb.Session{
+		Id:              s.ID,
+		UserId:          s.UserID,
+		WorkspaceId:     s.WorkspaceID,
+		Device:          s.Device,
+		Ip:              s.IP,
+		CreatedAt:       s.CreatedAt,
+		LastRefreshedAt: s.LastRefreshedAt,
+		ExpiresAt:       s.ExpiresAt,
+	}
+}
+
+func mapWorkflowToPB(w dto.Workflow) *pb.Workflow {
+	workflow := &pb.Workflow{
+		IsDefault: w.IsDefault,
+	}
+
+	for _, status := range w.Statuses {
+		workflow.Statuses = append(workflow.Statuses, &pb.WorkflowStatus{
+			Id:       uint32(status.ID),
+			Name:     status.Name,
+			Category: pb.StatusCategory(status.Category),
+		})
+	}
+
+	for _, transition := range w.Transitions {
+		workflow.Transitions = append(workflow.Transitions, &pb.WorkflowTransition{
+			From: uint32(transition.From),
+			To:   uint32(transition.To),
+		})
+	}
+
+	return workflow
+}
+
+func mapTimeEntryToPB(e dto.TimeEntry) *pb.TimeEntry {
+	return &pb.TimeEntry{
+		Id:        e.ID,
+		TaskId:    e.TaskID,
+		Project:   e.Project,
+		StartedAt: e.StartedAt,
+		StoppedAt: e.StoppedAt,
+		Seconds:   e.Seconds,
 	}
 }
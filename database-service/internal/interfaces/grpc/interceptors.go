@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"log/slog"
+
+	"github.com/braunkc/todo-app/database-service/pkg/errors"
+	"github.com/braunkc/todo-app/database-service/pkg/identity"
+	pb "github.com/braunkc/todo-app/database-service/proto/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// methods that are called before the user is known (registration, login, calendar feeds and refreshing sessions)
+var publicMethods = map[string]bool{
+	pb.DataBaseService_CreateUser_FullMethodName:          true,
+	pb.DataBaseService_Authenticate_FullMethodName:        true,
+	pb.DataBaseService_ResolveCalendarFeed_FullMethodName: true,
+	pb.DataBaseService_RefreshSession_FullMethodName:      true,
+}
+
+// errorCodes are the codes domain errors are reported with, their messages are meant for users.
+var errorCodes = map[error]codes.Code{
+	errors.ErrEmptyField:                 codes.InvalidArgument,
+	errors.ErrTooLongField:               codes.InvalidArgument,
+	errors.ErrInvalidField:               codes.InvalidArgument,
+	errors.ErrMissingTemplateVariable:    codes.InvalidArgument,
+	errors.ErrTooManyTimeEntries:         codes.InvalidArgument,
+	errors.ErrFailedGetUserIDFromContext: codes.Unauthenticated,
+	errors.ErrInvalidCredentials:         codes.Unauthenticated,
+	errors.ErrInvalidRefreshToken:        codes.Unauthenticated,
+	errors.ErrRefreshTokenReused:         codes.Unauthenticated,
+	errors.ErrPermissionDenied:           codes.PermissionDenied,
+	errors.ErrNotFound:                   codes.NotFound,
+	errors.ErrTransitionNotAllowed:       codes.FailedPrecondition,
+	errors.ErrStatusInUse:                codes.FailedPrecondition,
+	errors.ErrTimerNotRunning:            codes.FailedPrecondition,
+	errors.ErrNothingToUndo:              codes.FailedPrecondition,
+	errors.ErrNothingToRedo:              codes.FailedPrecondition,
+	errors.ErrTaskChanged:                codes.FailedPrecondition,
+}
+
+// ErrorInterceptor reports domain errors with their codes. Any other error is logged
+// and reported as internal without its message, which may tell about the database.
+func ErrorInterceptor(l *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(l, info.FullMethod, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// ErrorStreamInterceptor is ErrorInterceptor for streaming methods.
+func ErrorStreamInterceptor(l *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return statusError(l, info.FullMethod, err)
+		}
+
+		return nil
+	}
+}
+
+func statusError(l *slog.Logger, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	for domainErr, code := range errorCodes {
+		if stderrors.Is(err, domainErr) {
+			return status.Error(code, err.Error())
+		}
+	}
+
+	l.Error("request failed", slog.String("method", method), slog.String("err", err.Error()))
+	return status.Error(codes.Internal, "internal error")
+}
+
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+// IdentityInterceptor verifies the identity assertion sent by the API service
+// and puts the user ID into the request context.
+func IdentityInterceptor(verifier identity.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// IdentityStreamInterceptor is IdentityInterceptor for streaming methods.
+func IdentityStreamInterceptor(verifier identity.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, verifier identity.Verifier, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	assertions := md.Get(identity.MetadataKey)
+	if len(assertions) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing identity assertion")
+	}
+
+	userID, workspaceID, err := verifier.Verify(assertions[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return identity.WithWorkspaceID(identity.WithUserID(ctx, userID), workspaceID), nil
+}
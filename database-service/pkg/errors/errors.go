@@ -6,6 +6,18 @@ var (
 	ErrEmptyField                 = errors.New("empty field")
 	ErrTooLongField               = errors.New("too long field")
 	ErrInvalidField               = errors.New("invalid field")
-	ErrFailedGetMetadata          = errors.New("failed get metadata from context")
 	ErrFailedGetUserIDFromContext = errors.New("failed get userID from context")
+	ErrPermissionDenied           = errors.New("permission denied")
+	ErrInvalidCredentials         = errors.New("invalid username or password")
+	ErrNotFound                   = errors.New("not found")
+	ErrTransitionNotAllowed       = errors.New("status transition not allowed")
+	ErrStatusInUse                = errors.New("status is used by tasks")
+	ErrTimerNotRunning            = errors.New("no running timer")
+	ErrTooManyTimeEntries         = errors.New("too many time entries in the range")
+	ErrNothingToUndo              = errors.New("nothing to undo")
+	ErrNothingToRedo              = errors.New("nothing to redo")
+	ErrTaskChanged                = errors.New("task changed since")
+	ErrMissingTemplateVariable    = errors.New("missing template variable")
+	ErrInvalidRefreshToken        = errors.New("invalid refresh token")
+	ErrRefreshTokenReused         = errors.New("refresh token reused")
 )
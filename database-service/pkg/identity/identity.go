@@ -0,0 +1,87 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key that carries the signed assertion.
+	MetadataKey = "x-identity-assertion"
+	Issuer      = "api-service"
+	Audience    = "database-service"
+)
+
+var ErrInvalidAssertion = errors.New("invalid identity assertion")
+
+type ctxKey struct{}
+
+type workspaceCtxKey struct{}
+
+// claims are the assertion's registered claims and the workspace the request is made in.
+type claims struct {
+	jwt.RegisteredClaims
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+type verifier struct {
+	secretKey []byte
+}
+
+type Verifier interface {
+	Verify(assertion string) (string, string, error)
+}
+
+func NewVerifier(secretKey []byte) Verifier {
+	return &verifier{
+		secretKey: secretKey,
+	}
+}
+
+// Verify checks the signature, issuer, audience and expiry of the assertion
+// and returns the user ID from its subject and the workspace ID, empty if it has none.
+func (v *verifier) Verify(assertion string) (string, string, error) {
+	token, err := jwt.ParseWithClaims(assertion, &claims{},
+		func(token *jwt.Token) (any, error) {
+			return v.secretKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(5*time.Second),
+	)
+	if err != nil {
+		return "", "", errors.Join(ErrInvalidAssertion, err)
+	}
+
+	c, ok := token.Claims.(*claims)
+	if !ok || c.Subject == "" {
+		return "", "", ErrInvalidAssertion
+	}
+
+	return c.Subject, c.WorkspaceID, nil
+}
+
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, userID)
+}
+
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(ctxKey{}).(string)
+	return userID, ok && userID != ""
+}
+
+func WithWorkspaceID(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, workspaceCtxKey{}, workspaceID)
+}
+
+// WorkspaceIDFromContext returns the workspace the request is made in,
+// false if the caller didn't choose one.
+func WorkspaceIDFromContext(ctx context.Context) (string, bool) {
+	workspaceID, ok := ctx.Value(workspaceCtxKey{}).(string)
+	return workspaceID, ok && workspaceID != ""
+}